@@ -1,14 +1,17 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/bytesparadise/libasciidoc"
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 
 	pkgprofile "github.com/pkg/profile"
 	log "github.com/sirupsen/logrus"
@@ -25,10 +28,17 @@ func NewRootCmd() *cobra.Command {
 	var backend string
 	var attributes []string
 	var profile string
+	var failureLevel string
+	var embedAssets bool
+	var destDir string
+	var baseDir string
+	var doctype string
+	var safeMode string
+	var templateDir string
 
 	rootCmd := &cobra.Command{
-		Use:   "libasciidoc [flags] FILE",
-		Short: `libasciidoc is a tool to convert from Asciidoc to HTML`,
+		Use:   "libasciidoc [flags] FILE|DIR|-",
+		Short: `libasciidoc is a tool to convert from Asciidoc to HTML, DocBook or man pages`,
 		Args:  cobra.ArbitraryArgs,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			lvl, err := log.ParseLevel(logLevel)
@@ -49,25 +59,89 @@ func NewRootCmd() *cobra.Command {
 			if len(args) == 0 {
 				return helpCommand.RunE(cmd, args)
 			}
+			failureSeverity, err := parseFailureLevel(failureLevel)
+			if err != nil {
+				return err
+			}
 			if profile == "cpu" {
 				defer pkgprofile.Start(pkgprofile.CPUProfile).Stop()
 			}
-			attrs := parseAttributes(attributes)
+			mode, err := configuration.ParseSafeMode(safeMode)
+			if err != nil {
+				return err
+			}
+			settings := []configuration.Setting{
+				configuration.WithAttributeOverrides(attributes),
+				configuration.WithCSS(css),
+				configuration.WithEmbedAssets(embedAssets),
+				configuration.WithSafeMode(mode),
+				configuration.WithBaseDir(baseDir),
+				configuration.WithBackEnd(backend),
+				configuration.WithTemplateDir(templateDir),
+				configuration.WithHeaderFooter(!noHeaderFooter),
+			}
+			if doctype != "" {
+				settings = append(settings, configuration.WithDocType(doctype))
+			}
+			// the output files of the source files keep the same relative paths in the destination directory
+			sourceDir := commonDir(args)
 			for _, sourcePath := range args {
-				out, close := getOut(cmd, sourcePath, outputName)
+				if sourcePath == "-" {
+					// convert the content read from STDIN
+					out, close := getOut(cmd, getOutputPath(sourcePath, outputName, destDir, sourceDir, backend))
+					if out == nil {
+						continue
+					}
+					defer close() //nolint:errcheck
+					config := configuration.NewConfiguration(append(settings, configuration.WithLastUpdated(time.Now()))...)
+					_, diagnostics, err := libasciidoc.ConvertWithDiagnostics(cmd.InOrStdin(), out, config)
+					if err != nil {
+						return err
+					}
+					if err := checkDiagnostics("STDIN", diagnostics, failureSeverity); err != nil {
+						return err
+					}
+					continue
+				}
+				if info, err := os.Stat(sourcePath); err == nil && info.IsDir() {
+					// convert all the documents of the directory at once, so that the cross references between them are resolved
+					outputDir := sourcePath
+					if outputName != "" && outputName != "-" {
+						outputDir = outputName
+					} else if destDir != "" {
+						outputDir = destDir
+					}
+					diagnostics, err := libasciidoc.ConvertDir(sourcePath, outputDir, settings...)
+					if err != nil {
+						return err
+					}
+					if err := checkDiagnostics(sourcePath, diagnostics, failureSeverity); err != nil {
+						return err
+					}
+					continue
+				}
+				outputPath := getOutputPath(sourcePath, outputName, destDir, sourceDir, backend)
+				if backend == "manpage" && outputName == "" {
+					// the output file is named after the title of the manpage, which is only known once the document is converted
+					if err := convertManpage(cmd, sourcePath, outputPath, settings, failureSeverity); err != nil {
+						return err
+					}
+					continue
+				}
+				out, close := getOut(cmd, outputPath)
 				if out != nil {
 					defer close() //nolint:errcheck
 					// log.Debugf("Starting to process file %v", path)
-					config := configuration.NewConfiguration(
+					config := configuration.NewConfiguration(append(settings,
 						configuration.WithFilename(sourcePath),
-						configuration.WithAttributes(attrs),
-						configuration.WithCSS(css),
-						configuration.WithBackEnd(backend),
-						configuration.WithHeaderFooter(!noHeaderFooter))
-					_, err := libasciidoc.ConvertFile(out, config)
+						configuration.WithOutputDir(getOutputDir(outputPath)))...)
+					_, diagnostics, err := libasciidoc.ConvertFileWithDiagnostics(out, config)
 					if err != nil {
 						return err
 					}
+					if err := checkDiagnostics(sourcePath, diagnostics, failureSeverity); err != nil {
+						return err
+					}
 				}
 			}
 			return nil
@@ -76,15 +150,44 @@ func NewRootCmd() *cobra.Command {
 	rootCmd.SilenceUsage = true
 	flags := rootCmd.Flags()
 	flags.BoolVarP(&noHeaderFooter, "no-header-footer", "s", false, "do not render header/footer (default: false)")
-	flags.StringVarP(&outputName, "out-file", "o", "", "output file (default: based on path of input file); use - to output to STDOUT")
+	flags.BoolVarP(&noHeaderFooter, "embedded", "e", false, "same as --no-header-footer")
+	flags.StringVarP(&outputName, "out-file", "o", "", "output file (default: based on path of input file); use - to output to STDOUT. When converting a directory, the output directory (default: the input directory)")
+	flags.StringVarP(&destDir, "destination-dir", "D", "", "the directory of the output files (default: the directory of each input file), in which the output files keep the relative paths of the input files")
+	flags.StringVarP(&baseDir, "base-dir", "B", "", "the directory in which the relative paths to the resources of the documents are resolved (default: the directory of each input file, or the current directory when reading from STDIN)")
+	flags.StringVarP(&doctype, "doctype", "d", "", "the document type [article|book|manpage] (default: article, or manpage with the manpage backend)")
+	flags.StringVarP(&safeMode, "safe-mode", "S", "unsafe", "the safe mode [unsafe|safe|server|secure]")
+	flags.StringVarP(&templateDir, "template-dir", "T", "", "the directory of the templates which override the templates of the backend, in files named after the templates (eg: Paragraph.tmpl)")
 	flags.StringVar(&logLevel, "log", "warn", "log level to set [debug|info|warn|error|fatal|panic]")
 	flags.StringArrayVarP(&css, "css", "", []string{}, "the paths to the CSS files to link to the document")
-	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, or name=value pair")
-	flags.StringVarP(&backend, "backend", "b", "html5", "backend to format the file")
+	flags.BoolVar(&embedAssets, "embed-assets", false, "embed the stylesheets, images and icons in the output, so that it is self-contained (default: false)")
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name or name=value, to soft-set with a trailing @ (eg: name=value@), or to unset in the form of name! or !name")
+	flags.StringVarP(&backend, "backend", "b", "html5", "backend to format the file [html5|xhtml5|docbook5|manpage]")
 	flags.StringVar(&profile, "profile", "", "enable profiling")
+	flags.StringVar(&failureLevel, "failure-level", "", "the minimum severity of the problems which make the command fail [warning|error] (default: none)")
 	return rootCmd
 }
 
+// converts the given source file into a manpage, in a file named after the title of the manpage (eg: `git.1`)
+// in the directory of the given output path
+func convertManpage(cmd *cobra.Command, sourcePath, outputPath string, settings []configuration.Setting, failureSeverity types.DiagnosticSeverity) error {
+	config := configuration.NewConfiguration(append(settings,
+		configuration.WithFilename(sourcePath),
+		configuration.WithOutputDir(getOutputDir(outputPath)))...)
+	result := &bytes.Buffer{}
+	metadata, diagnostics, err := libasciidoc.ConvertFileWithDiagnostics(result, config)
+	if err != nil {
+		return err
+	}
+	outputPath = filepath.Join(filepath.Dir(outputPath), libasciidoc.ManpageFilename(metadata, strings.TrimSuffix(filepath.Base(outputPath), filepath.Ext(outputPath))))
+	if out, close := getOut(cmd, outputPath); out != nil {
+		defer close() //nolint:errcheck
+		if _, err := out.Write(result.Bytes()); err != nil {
+			return err
+		}
+	}
+	return checkDiagnostics(sourcePath, diagnostics, failureSeverity)
+}
+
 type closeFunc func() error
 
 func defaultCloseFunc() closeFunc {
@@ -97,41 +200,108 @@ func newCloseFileFunc(c io.Closer) closeFunc {
 	}
 }
 
-func getOut(cmd *cobra.Command, sourcePath, outputName string) (io.Writer, closeFunc) {
-	if outputName == "-" {
+func getOut(cmd *cobra.Command, outputPath string) (io.Writer, closeFunc) {
+	if outputPath == "-" {
 		// outfile is STDOUT
 		return cmd.OutOrStdout(), defaultCloseFunc()
-	} else if outputName != "" {
+	}
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		log.Warnf("Cannot create output directory - %v, skipping", filepath.Dir(outputPath))
+		return nil, nil
+	}
+	outfile, err := os.Create(outputPath)
+	if err != nil {
+		log.Warnf("Cannot create output file - %v, skipping", outputPath)
+		return nil, nil
+	}
+	return outfile, newCloseFileFunc(outfile)
+}
+
+// returns the path to the output file of the given source file (or `-` for STDOUT): the given output name
+// (relative to the destination directory, if any), or the path to the source file with the extension of the backend.
+// In the destination directory, the output file keeps the path of the source file relative to the given source directory
+func getOutputPath(sourcePath, outputName, destDir, sourceDir, backend string) string {
+	switch {
+	case outputName == "-" || (outputName == "" && sourcePath == "-"):
+		return "-"
+	case outputName != "":
 		// outfile is specified in the command line
-		outfile, e := os.Create(outputName)
-		if e != nil {
-			log.Warnf("Cannot create output file - %v, skipping", outputName)
+		if destDir != "" && !filepath.IsAbs(outputName) {
+			return filepath.Join(destDir, outputName)
+		}
+		return outputName
+	}
+	// outfile is based on sourcePath
+	path, _ := filepath.Abs(sourcePath)
+	outname := strings.TrimSuffix(path, filepath.Ext(path)) + libasciidoc.OutfileSuffix(backend)
+	if destDir == "" {
+		return outname
+	}
+	if rel, err := filepath.Rel(sourceDir, outname); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.Join(destDir, rel)
+	}
+	return filepath.Join(destDir, filepath.Base(outname))
+}
+
+// returns the directory of the output file, in which the linked stylesheet is copied (if the `copycss` attribute is set),
+// or an empty string if the output is STDOUT
+func getOutputDir(outputPath string) string {
+	if outputPath == "-" {
+		return ""
+	}
+	return filepath.Dir(outputPath)
+}
+
+// returns the (absolute) directory which contains all the given source files
+func commonDir(sourcePaths []string) string {
+	result := ""
+	for _, p := range sourcePaths {
+		if p == "-" {
+			continue
 		}
-		return outfile, newCloseFileFunc(outfile)
-	} else if sourcePath != "" {
-		// outfile is based on sourcePath
-		path, _ := filepath.Abs(sourcePath)
-		outname := strings.TrimSuffix(path, filepath.Ext(path)) + ".html"
-		outfile, err := os.Create(outname)
+		path, err := filepath.Abs(p)
 		if err != nil {
-			log.Warnf("Cannot create output file - %v, skipping", outname)
-			return nil, nil
+			continue
+		}
+		dir := filepath.Dir(path)
+		if result == "" {
+			result = dir
+			continue
+		}
+		for result != filepath.Dir(result) && dir != result && !strings.HasPrefix(dir, result+string(filepath.Separator)) {
+			result = filepath.Dir(result)
 		}
-		return outfile, newCloseFileFunc(outfile)
 	}
-	return cmd.OutOrStdout(), defaultCloseFunc()
+	return result
+}
+
+// returns the minimum severity of the diagnostics which should make the command fail
+func parseFailureLevel(level string) (types.DiagnosticSeverity, error) {
+	switch strings.ToLower(level) {
+	case "":
+		return "", nil
+	case "warn", "warning":
+		return types.SeverityWarning, nil
+	case "error":
+		return types.SeverityError, nil
+	default:
+		return "", fmt.Errorf("invalid failure level: '%s'", level)
+	}
 }
 
-// converts the `name`, `!name` and `name=value` into a map
-func parseAttributes(attributes []string) map[string]interface{} {
-	result := make(map[string]interface{}, len(attributes))
-	for _, attr := range attributes {
-		data := strings.Split(attr, "=")
-		if len(data) > 1 {
-			result[data[0]] = data[1]
-		} else {
-			result[data[0]] = ""
+// returns an error if at least one of the given diagnostics has the given severity (or higher)
+func checkDiagnostics(sourcePath string, diagnostics []types.Diagnostic, failureSeverity types.DiagnosticSeverity) error {
+	if failureSeverity == "" {
+		return nil
+	}
+	count := 0
+	for _, d := range diagnostics {
+		if d.Severity.Level() >= failureSeverity.Level() {
+			count++
 		}
 	}
-	return result
+	if count > 0 {
+		return fmt.Errorf("failed to convert %s: %d problem(s) with severity '%s' or higher", sourcePath, count, failureSeverity)
+	}
+	return nil
 }
@@ -3,6 +3,8 @@ package main_test
 import (
 	"bytes"
 	"os"
+	"path/filepath"
+	"strings"
 
 	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
 
@@ -40,6 +42,60 @@ var _ = Describe("root cmd", func() {
 		Expect(content).ToNot(BeEmpty())
 	})
 
+	It("render with docbook5 backend and file output", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "docbook5", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		content, err := os.ReadFile("test/test.xml")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(HavePrefix(`<?xml version="1.0" encoding="UTF-8"?>`))
+		Expect(os.Remove("test/test.xml")).To(Succeed())
+	})
+
+	It("render with manpage backend and file output", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "manpage", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// no title: the manpage is named after the source file
+		content, err := os.ReadFile("test/test.1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring(`.TH "`))
+		Expect(os.Remove("test/test.1")).To(Succeed())
+	})
+
+	It("render with manpage backend and header only", func() {
+		// given
+		dir, err := os.MkdirTemp("", "libasciidoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		err = os.WriteFile(filepath.Join(dir, "foo.adoc"), []byte("= bar(8)"), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "manpage", filepath.Join(dir, "foo.adoc")})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// named after the title and volume of the manpage
+		content, err := os.ReadFile(filepath.Join(dir, "bar.8"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring(`.TH "BAR" "8"`))
+	})
+
 	It("fail to parse bad log level", func() {
 		// given
 		root := main.NewRootCmd()
@@ -66,6 +122,39 @@ var _ = Describe("root cmd", func() {
 		Expect(buf.String()).ToNot(ContainSubstring(`<div id="footer">`))
 	})
 
+	It("render with embedded assets", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-o", "-", "--embed-assets", "--css", "../../test/embed/style.css", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`src: url("data:font/woff2;base64,d09GMgABAAA=")`))
+		Expect(buf.String()).ToNot(ContainSubstring(`<link type="text/css"`))
+	})
+
+	It("render with linked and copied stylesheet", func() {
+		// given
+		dir, err := os.MkdirTemp("", "libasciidoc")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(os.RemoveAll, dir)
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-o", filepath.Join(dir, "test.html"), "-a", "linkcss", "-a", "copycss", "test/test.adoc"})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		content, err := os.ReadFile(filepath.Join(dir, "test.html"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring(`<link type="text/css" rel="stylesheet" href="./libasciidoc.css">`))
+		Expect(filepath.Join(dir, "libasciidoc.css")).To(BeARegularFile())
+	})
+
 	It("render with attribute set", func() {
 		// given
 		root := main.NewRootCmd()
@@ -102,6 +191,201 @@ var _ = Describe("root cmd", func() {
 `))
 	})
 
+	It("render with attributes set and soft-set", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "-afoo1=bar1", "-afoo2=bar2@", "test/doc_with_attribute_declarations.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`<div class="paragraph">
+<p>bar1 and FOO2</p>
+</div>
+`))
+	})
+
+	It("render with attribute value containing an equal sign", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "-afoo1=a=b", "test/doc_with_attribute_declarations.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`<div class="paragraph">
+<p>a=b and FOO2</p>
+</div>
+`))
+	})
+
+	It("render with attribute unset", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "-afoo1!", "test/doc_with_attribute_declarations.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`<div class="paragraph">
+<p>{foo1} and FOO2</p>
+</div>
+`))
+	})
+
+	It("render with doctype", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-o", "-", "-d", "book", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`<body class="book">`))
+	})
+
+	It("render embedded", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-e", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).ToNot(ContainSubstring(`<html`))
+	})
+
+	It("render from STDIN", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetIn(strings.NewReader("*hello* from {safe-mode-name} mode"))
+		root.SetArgs([]string{"-s", "-S", "secure", "-"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`<div class="paragraph">
+<p><strong>hello</strong> from secure mode</p>
+</div>
+`))
+	})
+
+	It("render from STDIN with base dir", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetIn(strings.NewReader("include::chapter-a.adoc[leveloffset=+1]"))
+		root.SetArgs([]string{"-s", "-B", "../../test/includes", "-"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`<h2 id="_chapter_a">Chapter A</h2>`))
+	})
+
+	It("fail with invalid safe mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-S", "paranoid", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(MatchError("invalid safe mode: 'paranoid'"))
+	})
+
+	It("render in destination dir", func() {
+		// given
+		sourceDir, err := os.MkdirTemp("", "libasciidoc-src")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(os.RemoveAll, sourceDir)
+		Expect(os.MkdirAll(filepath.Join(sourceDir, "sub"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(sourceDir, "a.adoc"), []byte("a"), 0600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(sourceDir, "sub", "b.adoc"), []byte("b"), 0600)).To(Succeed())
+		destDir, err := os.MkdirTemp("", "libasciidoc-dest")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(os.RemoveAll, destDir)
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-D", destDir, filepath.Join(sourceDir, "a.adoc"), filepath.Join(sourceDir, "sub", "b.adoc")})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(filepath.Join(destDir, "a.html")).To(BeARegularFile())
+		Expect(filepath.Join(destDir, "sub", "b.html")).To(BeARegularFile())
+		Expect(filepath.Join(sourceDir, "a.html")).NotTo(BeAnExistingFile())
+	})
+
+	It("render with template dir", func() {
+		// given
+		templateDir, err := os.MkdirTemp("", "libasciidoc-templates")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(os.RemoveAll, templateDir)
+		Expect(os.WriteFile(filepath.Join(templateDir, "Paragraph.tmpl"), []byte(`<p class="house">{{ .Content }}</p>`), 0600)).To(Succeed())
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetIn(strings.NewReader("a paragraph"))
+		root.SetArgs([]string{"-s", "-T", templateDir, "-"})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`<p class="house">a paragraph</p>`))
+	})
+
+	It("fail when problems reach the failure level", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "--failure-level", "warning", "-afoo1=bar1", "test/doc_with_attributes.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(MatchError("failed to convert test/doc_with_attributes.adoc: 1 problem(s) with severity 'warning' or higher"))
+	})
+
+	It("do not fail when problems are below the failure level", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "--failure-level", "error", "-afoo1=bar1", "test/doc_with_attributes.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("fail to parse bad failure level", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "--failure-level", "info", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(MatchError("invalid failure level: 'info'"))
+	})
+
 	It("render multiple files", func() {
 		// given
 		root := main.NewRootCmd()
@@ -126,6 +410,39 @@ var _ = Describe("root cmd", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("render directory", func() {
+		// given
+		outputDir, err := os.MkdirTemp("", "libasciidoc-site")
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(os.RemoveAll, outputDir)
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", outputDir, "../../test/site"})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		content, err := os.ReadFile(filepath.Join(outputDir, "guide", "install.html"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring(`<a href="../index.html">the home page</a>`))
+	})
+
+	It("fail when cross references between documents of directory are broken", func() {
+		// given
+		outputDir, err := os.MkdirTemp("", "libasciidoc-site")
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(os.RemoveAll, outputDir)
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", outputDir, "--failure-level", "warning", "../../test/site"})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).To(MatchError("failed to convert ../../test/site: 2 problem(s) with severity 'warning' or higher"))
+	})
+
 	It("show help when executed with no arg", func() {
 		// given
 		root := main.NewRootCmd()
@@ -0,0 +1,112 @@
+// Command svg-icons generates the Go source of the SVG icons used when the `icons` attribute is set to `svg`,
+// from the SVG font and the stylesheet of Font Awesome 4.7 (by Dave Gandy - http://fontawesome.io, SIL OFL 1.1 License).
+//
+// Usage:
+//
+//	go run ./code-gen/svg-icons path/to/fontawesome-webfont.svg path/to/font-awesome.css | gofmt > pkg/renderer/sgml/icon_svg_data.go
+package main
+
+import (
+	"fmt"
+	"html"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// the icons to bundle: the icons of the admonitions, along with the most common icons
+var names = []string{
+	// admonitions
+	"info-circle", "lightbulb-o", "warning", "fire", "exclamation-circle",
+	// common icons
+	"arrow-down", "arrow-left", "arrow-right", "arrow-up", "ban", "bar-chart", "bell", "bolt", "book", "bookmark", "bug",
+	"calendar", "camera", "check", "check-circle", "check-square-o", "chevron-down", "chevron-left", "chevron-right", "chevron-up",
+	"circle", "circle-o", "clock-o", "cloud", "code", "code-fork", "coffee", "cog", "cogs", "comment", "comments", "copy",
+	"database", "download", "edit", "envelope", "exclamation", "exclamation-triangle", "external-link", "eye", "facebook",
+	"file", "file-code-o", "file-o", "file-pdf-o", "file-text-o", "flag", "folder", "folder-open", "gift", "git", "github",
+	"gitlab", "globe", "heart", "heart-o", "home", "image", "info", "key", "laptop", "link", "linkedin", "list", "list-ol",
+	"list-ul", "lock", "map-marker", "minus", "music", "paperclip", "pause", "pencil", "phone", "play", "plus", "print",
+	"question", "question-circle", "quote-left", "quote-right", "refresh", "rss", "save", "search", "share", "shield",
+	"sitemap", "square", "square-o", "star", "star-half-o", "star-o", "stop", "tag", "tags", "tasks", "terminal",
+	"thumbs-down", "thumbs-up", "times", "times-circle", "trash", "twitter", "unlock", "upload", "user", "users",
+	"wrench", "youtube",
+}
+
+var (
+	// eg: `.fa-warning:before,.fa-exclamation-triangle:before{content:"\f071"}`
+	ruleRx = regexp.MustCompile(`((?:\.fa-[\w-]+:before,?)+)\{content:"\\(\w+)"\}`)
+	nameRx = regexp.MustCompile(`\.fa-([\w-]+):before`)
+	// eg: `<glyph glyph-name="warning_sign" unicode="&#xf071;" horiz-adv-x="1792" d="..." />`
+	glyphRx   = regexp.MustCompile(`(?s)<glyph\s([^>]*?)/>`)
+	unicodeRx = regexp.MustCompile(`unicode="([^"]*)"`)
+	advanceRx = regexp.MustCompile(`horiz-adv-x="(\d+)"`)
+	pathRx    = regexp.MustCompile(`\sd="([^"]*)"`)
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatalf("usage: %s FONT_SVG FONT_CSS", os.Args[0])
+	}
+	font, err := os.ReadFile(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	css, err := os.ReadFile(os.Args[2])
+	if err != nil {
+		log.Fatal(err)
+	}
+	// code points of the icons, by name
+	codepoints := map[string]string{}
+	for _, rule := range ruleRx.FindAllStringSubmatch(string(css), -1) {
+		for _, name := range nameRx.FindAllStringSubmatch(rule[1], -1) {
+			codepoints[name[1]] = rule[2]
+		}
+	}
+	// glyphs of the font, by code point
+	type glyph struct {
+		width int
+		path  string
+	}
+	glyphs := map[string]glyph{}
+	for _, g := range glyphRx.FindAllStringSubmatch(string(font), -1) {
+		u := unicodeRx.FindStringSubmatch(g[1])
+		d := pathRx.FindStringSubmatch(g[1])
+		if u == nil || d == nil {
+			continue
+		}
+		width := 1536 // default `horiz-adv-x` of the font
+		if a := advanceRx.FindStringSubmatch(g[1]); a != nil {
+			width, _ = strconv.Atoi(a[1])
+		}
+		r := []rune(html.UnescapeString(u[1]))
+		if len(r) != 1 {
+			continue
+		}
+		glyphs[strconv.FormatInt(int64(r[0]), 16)] = glyph{
+			width: width,
+			path:  strings.Join(strings.Fields(d[1]), " "),
+		}
+	}
+	sort.Strings(names)
+	out := &strings.Builder{}
+	out.WriteString("// Code generated by code-gen/svg-icons; DO NOT EDIT.\n\n")
+	out.WriteString("// Icons from Font Awesome 4.7 by Dave Gandy - http://fontawesome.io (SIL OFL 1.1 License)\n\n")
+	out.WriteString("package sgml\n\n")
+	out.WriteString("var svgIcons = map[string]svgIconData{\n")
+	for _, name := range names {
+		cp, found := codepoints[name]
+		if !found {
+			log.Fatalf("unknown icon: %s", name)
+		}
+		g, found := glyphs[cp]
+		if !found {
+			log.Fatalf("missing glyph for icon '%s' (%s)", name, cp)
+		}
+		fmt.Fprintf(out, "%q: {width: %d, path: %q},\n", name, g.width, g.path)
+	}
+	out.WriteString("}\n")
+	fmt.Print(out.String())
+}
@@ -3,8 +3,11 @@
 package libasciidoc
 
 import (
+	"bytes"
+	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -31,71 +34,349 @@ var (
 // The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
 // as the result of the function call.  The output format is determined by config.Backend (HTML5 default).
 func ConvertFile(output io.Writer, config *configuration.Configuration) (types.Metadata, error) {
+	metadata, _, err := ConvertFileWithDiagnostics(output, config)
+	return metadata, err
+}
+
+// ConvertFileWithDiagnostics converts the content of the given filename into an output document, like `ConvertFile`,
+// and also returns the diagnostics (ie, the problems) reported during the conversion.
+func ConvertFileWithDiagnostics(output io.Writer, config *configuration.Configuration) (types.Metadata, []types.Diagnostic, error) {
 	file, err := os.Open(config.Filename)
 	if err != nil {
-		return types.Metadata{}, errors.Wrapf(err, "error opening %s", config.Filename)
+		return types.Metadata{}, nil, errors.Wrapf(err, "error opening %s", config.Filename)
 	}
 	defer file.Close()
 	// use the file mtime as the `last updated` value
 	stat, err := os.Stat(config.Filename)
 	if err != nil {
-		return types.Metadata{}, errors.Wrapf(err, "error opening %s", config.Filename)
+		return types.Metadata{}, nil, errors.Wrapf(err, "error opening %s", config.Filename)
 	}
 	config.LastUpdated = stat.ModTime()
-	return Convert(file, output, config)
+	return ConvertWithDiagnostics(file, output, config)
 }
 
 // Convert converts the content of the given reader `r` into a full output document, written in the given writer `output`.
 // Returns an error if a problem occurred. The default will be HTML5, but depends on the config.BackEnd value.
 func Convert(source io.Reader, output io.Writer, config *configuration.Configuration) (types.Metadata, error) {
+	metadata, _, err := ConvertWithDiagnostics(source, output, config)
+	return metadata, err
+}
+
+// ConvertWithDiagnostics converts the content of the given reader `r` into a full output document, like `Convert`,
+// and also returns the diagnostics (ie, the problems) reported during the conversion, including when an error occurred.
+// The diagnostics are also collected in config.Diagnostics, if set.
+func ConvertWithDiagnostics(source io.Reader, output io.Writer, config *configuration.Configuration) (types.Metadata, []types.Diagnostic, error) {
+	if config.Diagnostics == nil {
+		config.Diagnostics = types.NewDiagnostics()
+		defer func() {
+			config.Diagnostics = nil
+		}()
+	}
+	metadata, err := convert(source, output, config)
+	return metadata, config.Diagnostics.All(), err
+}
+
+// ConvertDir converts all the AsciiDoc files (`.adoc`, `.asciidoc`, `.asc` and `.ad`) of the given source directory and of its
+// subdirectories into output documents, written in the given output directory with the same relative paths.
+// Files and directories whose name starts with `_` (eg: partials to include in other documents) or `.` are skipped.
+// All documents are parsed before any of them is rendered, so that the cross references between them
+// (eg: `xref:other.adoc#section[]` or `<<other.adoc#section>>`) are resolved with the titles of their targets, and reported
+// in the returned diagnostics if they are broken.
+// The output files have the extension set in the `outfilesuffix` attribute of each document, or the default extension of the backend
+// (eg: `.html`), and the cross references between the documents link to these output files.
+func ConvertDir(sourceDir, outputDir string, settings ...configuration.Setting) ([]types.Diagnostic, error) {
+	sources, err := findSources(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+	diagnostics := types.NewDiagnostics()
+	catalog := types.NewCatalog()
+	type parsedDocument struct {
+		doc        *types.Document
+		config     *configuration.Configuration
+		outputPath string
+	}
+	docs := make([]parsedDocument, 0, len(sources))
+	for _, sourcePath := range sources {
+		config := configuration.NewConfiguration(settings...)
+		// each document has its own attributes, even if they were initialized with the same settings
+		config.Attributes = config.Attributes.Clone()
+		config.Filename = sourcePath
+		config.Diagnostics = diagnostics
+		config.Catalog = catalog
+		doc, err := parseFile(config)
+		if err != nil {
+			return diagnostics.All(), err
+		}
+		// each document is written with its own suffix, and the links to it are resolved with its output path in the catalog
+		config.Attributes[types.AttrOutFileSuffix] = headerAttribute(doc, config, types.AttrOutFileSuffix, OutfileSuffix(config.BackEnd))
+		rel, err := filepath.Rel(sourceDir, sourcePath)
+		if err != nil {
+			return diagnostics.All(), err
+		}
+		outputPath := filepath.Join(outputDir, strings.TrimSuffix(rel, filepath.Ext(rel))+config.Attributes.GetAsStringWithDefault(types.AttrOutFileSuffix, ""))
+		catalog.Add(sourcePath, doc)
+		catalog.SetOutputPath(sourcePath, outputPath)
+		docs = append(docs, parsedDocument{
+			doc:        doc,
+			config:     config,
+			outputPath: outputPath,
+		})
+	}
+	for _, d := range docs {
+		d.config.OutputDir = filepath.Dir(d.outputPath)
+		if err := renderFile(d.doc, d.outputPath, d.config); err != nil {
+			return diagnostics.All(), err
+		}
+	}
+	return diagnostics.All(), nil
+}
+
+// findSources returns the paths to the AsciiDoc files to convert in the given directory and its subdirectories
+func findSources(dir string) ([]string, error) {
+	sources := []string{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dir && (strings.HasPrefix(info.Name(), "_") || strings.HasPrefix(info.Name(), ".")) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.IsDir() {
+			switch filepath.Ext(path) {
+			case ".adoc", ".asciidoc", ".asc", ".ad":
+				sources = append(sources, path)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading %s", dir)
+	}
+	return sources, nil
+}
+
+// parseFile parses the file at config.Filename
+func parseFile(config *configuration.Configuration) (*types.Document, error) {
+	file, err := os.Open(config.Filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening %s", config.Filename)
+	}
+	defer file.Close()
+	// use the file mtime as the `last updated` value
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening %s", config.Filename)
+	}
+	config.LastUpdated = stat.ModTime()
+	return parse(file, config)
+}
+
+// renderFile renders the given document in the file at the given path (creating its parent directories if needed).
+// The output file of a manpage is named after its title (see `ManpageFilename`).
+func renderFile(doc *types.Document, path string, config *configuration.Configuration) error {
+	if config.BackEnd == "manpage" {
+		output := &bytes.Buffer{}
+		metadata, err := render(doc, output, config)
+		if err != nil {
+			return err
+		}
+		path = filepath.Join(filepath.Dir(path), ManpageFilename(metadata, strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return errors.Wrapf(err, "error creating directory of %s", path)
+		}
+		return errors.Wrapf(os.WriteFile(path, output.Bytes(), 0644), "error writing %s", path) //nolint:gosec
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "error creating directory of %s", path)
+	}
+	output, err := os.Create(path)
+	if err != nil {
+		return errors.Wrapf(err, "error creating %s", path)
+	}
+	defer output.Close()
+	_, err = render(doc, output, config)
+	return err
+}
+
+// ManpageFilename returns the name of the output file of a manpage, ie, `<mantitle>.<manvolnum>` as in Asciidoctor
+// (eg: `git.1`), in which the `mantitle` defaults to the given name (eg: the name of the source file without its extension)
+func ManpageFilename(metadata types.Metadata, name string) string {
+	if metadata.ManTitle != "" {
+		// the title must not be a path to another directory
+		name = strings.NewReplacer("/", "-", `\`, "-").Replace(metadata.ManTitle)
+	}
+	volnum := metadata.ManVolNum
+	if volnum == "" {
+		volnum = "1"
+	}
+	return name + "." + volnum
+}
+
+// OutfileSuffix returns the default extension of the output files for the given backend (eg: `.html`)
+func OutfileSuffix(backend string) string {
+	switch backend {
+	case "docbook", "docbook5":
+		return ".xml"
+	case "manpage":
+		return ".man"
+	default:
+		return ".html"
+	}
+}
+
+func convert(source io.Reader, output io.Writer, config *configuration.Configuration) (types.Metadata, error) {
+	start := time.Now()
+	defer func() {
+		log.Infof("total time         %d microseconds", time.Since(start).Microseconds())
+	}()
+	doc, err := parse(source, config)
+	if err != nil {
+		return types.Metadata{}, err
+	}
+	return render(doc, output, config)
+}
 
-	var start, endOfPreprocess, emdOfParse, endOfValidate, endOfRender time.Time
+// parse preprocesses, parses and validates the document
+func parse(source io.Reader, config *configuration.Configuration) (*types.Document, error) {
+	var start, endOfPreprocess, emdOfParse, endOfValidate time.Time
 	start = time.Now()
 	defer func() {
 		log.Infof("time to preprocess %d microseconds", endOfPreprocess.Sub(start).Microseconds())
 		log.Infof("time to parse      %d microseconds", emdOfParse.Sub(endOfPreprocess).Microseconds())
 		log.Infof("time to validate   %d microseconds", endOfValidate.Sub(emdOfParse).Microseconds())
-		log.Infof("time to render     %d microseconds", endOfRender.Sub(endOfValidate).Microseconds())
-		log.Infof("total time         %d microseconds", endOfRender.Sub(start).Microseconds())
 	}()
-	p, err := parser.Preprocess(source, config)
+	source, err := preprocessSource(source, config)
 	if err != nil {
-		return types.Metadata{}, err
+		return nil, err
+	}
+	p, sourceMap, err := parser.PreprocessWithSourceMap(source, config)
+	if err != nil {
+		return nil, err
 	}
 	endOfPreprocess = time.Now()
 	// log.Debugf("parsing the asciidoc source...")
-	doc, err := parser.ParseDocument(strings.NewReader(p), config)
+	doc, err := parser.ParseDocument(strings.NewReader(p), config, parser.WithSourceMap(sourceMap))
 	if err != nil {
-		return types.Metadata{}, err
+		return nil, err
 	}
 	emdOfParse = time.Now()
 	// validate the document
-	doctype := config.Attributes.GetAsStringWithDefault(types.AttrDocType, "article")
-	problems, err := validator.Validate(doc, doctype)
+	problems, err := validator.Validate(doc, doctype(doc, config))
 	if err != nil {
-		return types.Metadata{}, err
+		return nil, err
 	}
 	endOfValidate = time.Now()
-	if len(problems) > 0 {
-		// if any problem found, change the doctype to render the document as a regular article
+	hasErrors := false
+	for _, problem := range problems {
+		msg := problem.Message
+		if problem.Position != nil {
+			msg = fmt.Sprintf("%s: %s", problem.Position, problem.Message)
+		}
+		switch problem.Severity {
+		case types.SeverityError:
+			hasErrors = true
+			log.Error(msg)
+			config.Diagnostics.Errorf(types.InvalidDocumentStructure, problem.Position, "%s", problem.Message)
+		case types.SeverityWarning:
+			log.Warn(msg)
+			config.Diagnostics.Warnf(types.InvalidDocumentStructure, problem.Position, "%s", problem.Message)
+		}
+	}
+	if hasErrors {
+		// if any error found, change the doctype to render the document as a regular article
 		log.Warnf("changing doctype to 'article' because problems were found in the document: %v", problems)
 		config.Attributes[types.AttrDocType] = "article" // switch to `article` rendering (in case it was a manpage with problems)
-		for _, problem := range problems {
-			switch problem.Severity {
-			case validator.Error:
-				log.Error(problem.Message)
-			case validator.Warning:
-				log.Warn(problem.Message)
-			}
+	}
+	for _, process := range config.Extensions.TreeProcessors {
+		if err := process(doc); err != nil {
+			return nil, errors.Wrap(err, "error while processing the document")
+		}
+	}
+	return doc, nil
+}
+
+// preprocessSource applies the preprocessors of the configuration (if any) on the given source
+func preprocessSource(source io.Reader, config *configuration.Configuration) (io.Reader, error) {
+	if len(config.Extensions.Preprocessors) == 0 {
+		return source, nil
+	}
+	content, err := io.ReadAll(source)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while reading the document")
+	}
+	result := string(content)
+	for _, process := range config.Extensions.Preprocessors {
+		if result, err = process(result); err != nil {
+			return nil, errors.Wrap(err, "error while preprocessing the document")
 		}
 	}
-	// render
-	metadata, err := renderer.Render(doc, config, output)
+	return strings.NewReader(result), nil
+}
+
+// render renders the parsed document in the given output
+func render(doc *types.Document, output io.Writer, config *configuration.Configuration) (types.Metadata, error) {
+	start := time.Now()
+	out := output
+	var result *strings.Builder
+	if len(config.Extensions.Postprocessors) > 0 {
+		// render in a buffer, so that the postprocessors can transform the whole output
+		result = &strings.Builder{}
+		out = result
+	}
+	metadata, err := renderer.Render(doc, config, out)
 	if err != nil {
 		return types.Metadata{}, err
 	}
-	endOfRender = time.Now()
+	log.Infof("time to render     %d microseconds", time.Since(start).Microseconds())
+	if result != nil {
+		if err := postprocess(result.String(), output, config); err != nil {
+			return types.Metadata{}, err
+		}
+	}
 	// log.Debugf("Done processing document")
 	return metadata, nil
+}
 
+// postprocess applies the postprocessors of the configuration on the given rendered document, and writes the result in the given output
+func postprocess(result string, output io.Writer, config *configuration.Configuration) error {
+	var err error
+	for _, process := range config.Extensions.Postprocessors {
+		if result, err = process(result); err != nil {
+			return errors.Wrap(err, "error while postprocessing the document")
+		}
+	}
+	if _, err := io.WriteString(output, result); err != nil {
+		return errors.Wrap(err, "error while writing the document")
+	}
+	return nil
+}
+
+// doctype returns the doctype declared in the document header, or in the configuration (defaults to `article`)
+func doctype(doc *types.Document, config *configuration.Configuration) string {
+	return headerAttribute(doc, config, types.AttrDocType, "article")
+}
+
+// headerAttribute returns the value of the attribute set in the configuration, or declared in the document header,
+// or soft-set in the configuration (or the given default value if the attribute is not declared or if it is unset)
+func headerAttribute(doc *types.Document, config *configuration.Configuration, name, defaultValue string) string {
+	if v, found := config.Attributes.GetAsString(name); found {
+		return v
+	}
+	if config.UnsetAttributes[name] {
+		return defaultValue
+	}
+	if header, _ := doc.Header(); header != nil {
+		for _, e := range header.Elements {
+			if a, ok := e.(*types.AttributeDeclaration); ok && a.Name == name {
+				if v, ok := a.Value.(string); ok {
+					return v
+				}
+			}
+		}
+	}
+	return config.SoftAttributes.GetAsStringWithDefault(name, defaultValue)
 }
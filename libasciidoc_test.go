@@ -1,12 +1,15 @@
 package libasciidoc_test
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/bytesparadise/libasciidoc"
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -429,6 +432,9 @@ Our story begins.`
 <meta http-equiv="X-UA-Compatible" content="IE=edge">
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
+<style>
+{{ .CSS }}
+</style>
 <title>Story</title>
 </head>
 <body class="article">
@@ -461,8 +467,10 @@ Last updated {{ .LastUpdated }}
 				Expect(out.String()).To(MatchHTMLTemplate(expectedTmpl,
 					struct {
 						LastUpdated string
+						CSS         string
 					}{
 						LastUpdated: lastUpdated.Format(configuration.LastUpdatedFormat),
+						CSS:         sgml.DefaultStylesheet,
 					}))
 			})
 
@@ -477,6 +485,9 @@ Our story begins.`
 <meta http-equiv="X-UA-Compatible" content="IE=edge"/>
 <meta name="viewport" content="width=device-width, initial-scale=1.0"/>
 <meta name="generator" content="libasciidoc"/>
+<style>
+{{ .CSS }}
+</style>
 <title>Story</title>
 </head>
 <body class="article">
@@ -509,8 +520,10 @@ Last updated {{ .LastUpdated }}
 				Expect(out.String()).To(MatchHTMLTemplate(expectedTmpl,
 					struct {
 						LastUpdated string
+						CSS         string
 					}{
 						LastUpdated: lastUpdated.Format(configuration.LastUpdatedFormat),
+						CSS:         sgml.DefaultStylesheet,
 					}))
 			})
 
@@ -534,4 +547,489 @@ Our story begins.`
 		})
 	})
 
+	Context("diagnostics", func() {
+
+		It("should report missing attribute, unresolved footnote and unresolved cross reference", func() {
+			source := `= Title
+
+a paragraph with {unknown} attribute.
+
+a paragraph with footnote:unknown[] reference.
+
+a paragraph with <<unknown>> reference.`
+			filename, err := filepath.Abs("test.adoc")
+			Expect(err).NotTo(HaveOccurred())
+			out := &strings.Builder{}
+			_, diagnostics, err := libasciidoc.ConvertWithDiagnostics(
+				strings.NewReader(source),
+				out,
+				configuration.NewConfiguration(
+					configuration.WithFilename("test.adoc"),
+					configuration.WithLastUpdated(lastUpdated),
+				))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diagnostics).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.MissingAttribute,
+					Message:  "unable to find entry for attribute with key 'unknown' in context",
+					Position: &types.SourcePosition{File: filename, Line: 3, Column: 1},
+				},
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.UnresolvedFootnoteReference,
+					Message:  "no footnote with reference 'unknown'",
+					Position: &types.SourcePosition{File: filename, Line: 5, Column: 1},
+				},
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.UnresolvedCrossReference,
+					Message:  "invalid reference: unknown",
+					Position: &types.SourcePosition{File: filename, Line: 7, Column: 1},
+				},
+			}))
+		})
+
+		It("should report problems in invalid manpage", func() {
+			source := `= eve(1)
+
+== Foo
+
+eve - analyzes an image to determine if it's a picture of a life form`
+			out := &strings.Builder{}
+			_, diagnostics, err := libasciidoc.ConvertWithDiagnostics(
+				strings.NewReader(source),
+				out,
+				configuration.NewConfiguration(
+					configuration.WithFilename("test.adoc"),
+					configuration.WithBackEnd("manpage"),
+					configuration.WithLastUpdated(lastUpdated),
+				))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diagnostics).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityError,
+					Code:     types.InvalidDocumentStructure,
+					Message:  "manpage document is missing the 'Name' section",
+				},
+			}))
+		})
+
+		It("should report missing include along with error", func() {
+			source := `a paragraph
+
+include::unknown.adoc[]`
+			filename, err := filepath.Abs("test.adoc")
+			Expect(err).NotTo(HaveOccurred())
+			out := &strings.Builder{}
+			_, diagnostics, err := libasciidoc.ConvertWithDiagnostics(
+				strings.NewReader(source),
+				out,
+				configuration.NewConfiguration(
+					configuration.WithFilename("test.adoc"),
+					configuration.WithLastUpdated(lastUpdated),
+				))
+			Expect(err).To(HaveOccurred())
+			Expect(diagnostics).To(HaveLen(1))
+			Expect(diagnostics[0].Severity).To(Equal(types.SeverityError))
+			Expect(diagnostics[0].Code).To(Equal(types.UnresolvedInclude))
+			Expect(diagnostics[0].Message).To(HavePrefix("Unresolved directive in test.adoc - include::unknown.adoc[]"))
+			Expect(diagnostics[0].Position).To(Equal(&types.SourcePosition{File: filename, Line: 3, Column: 1}))
+		})
+
+		It("should collect diagnostics in configuration", func() {
+			source := `a paragraph with {unknown} attribute.`
+			out := &strings.Builder{}
+			d := types.NewDiagnostics()
+			_, err := libasciidoc.Convert(
+				strings.NewReader(source),
+				out,
+				configuration.NewConfiguration(
+					configuration.WithFilename("test.adoc"),
+					configuration.WithLastUpdated(lastUpdated),
+					configuration.WithDiagnostics(d),
+				))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(d.All()).To(HaveLen(1))
+			Expect(d.All()[0].Code).To(Equal(types.MissingAttribute))
+		})
+	})
+
+	Context("directories", func() {
+
+		It("should convert all documents and resolve the cross references between them", func() {
+			outputDir, err := os.MkdirTemp("", "libasciidoc-site")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, outputDir)
+			source, err := filepath.Abs(filepath.Join("test", "site", "index.adoc"))
+			Expect(err).NotTo(HaveOccurred())
+			diagnostics, err := libasciidoc.ConvertDir("test/site", outputDir,
+				configuration.WithLastUpdated(lastUpdated),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diagnostics).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.UnresolvedCrossReference,
+					Message:  "invalid reference to document: missing.adoc",
+					Position: &types.SourcePosition{File: source, Line: 3, Column: 1},
+				},
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.UnresolvedCrossReference,
+					Message:  "invalid reference: guide/install.adoc#unknown",
+					Position: &types.SourcePosition{File: source, Line: 3, Column: 1},
+				},
+			}))
+			index, err := os.ReadFile(filepath.Join(outputDir, "index.html"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(index)).To(ContainSubstring(`<p>See <a href="guide/install.html#requirements">Requirements</a> in <a href="guide/install.html">Installation</a>, but not <a href="missing.html">missing.html</a> nor <a href="guide/install.html#unknown">guide/install.html</a>.</p>`))
+			install, err := os.ReadFile(filepath.Join(outputDir, "guide", "install.html"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(install)).To(ContainSubstring(`<p>Back to <a href="../index.html">the home page</a>.</p>`))
+			Expect(string(install)).To(ContainSubstring(`<p>See <a href="#requirements">above</a>.</p>`))
+			// partials are not converted
+			Expect(filepath.Join(outputDir, "_partials", "note.html")).NotTo(BeAnExistingFile())
+		})
+
+		It("should use the output file suffix and relative file prefix in cross references", func() {
+			outputDir, err := os.MkdirTemp("", "libasciidoc-site")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, outputDir)
+			_, err = libasciidoc.ConvertDir("test/site", outputDir,
+				configuration.WithLastUpdated(lastUpdated),
+				configuration.WithAttributes(map[string]interface{}{
+					types.AttrOutFileSuffix: ".htm",
+					types.AttrRelFilePrefix: "/docs/",
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			index, err := os.ReadFile(filepath.Join(outputDir, "index.htm"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(index)).To(ContainSubstring(`<p>See <a href="/docs/guide/install.htm#requirements">Requirements</a> in <a href="/docs/guide/install.htm">Installation</a>`))
+			Expect(filepath.Join(outputDir, "guide", "install.htm")).To(BeAnExistingFile())
+		})
+
+		It("should link to the output files of the documents with different suffixes", func() {
+			sourceDir, err := os.MkdirTemp("", "libasciidoc-site")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, sourceDir)
+			Expect(os.MkdirAll(filepath.Join(sourceDir, "sub"), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(sourceDir, "index.adoc"), []byte(`= Home
+
+See xref:sub/page.adoc[the page].`), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(sourceDir, "sub", "page.adoc"), []byte(`= Page
+:outfilesuffix: .htm
+
+Back to xref:../index.adoc[the home page].`), 0644)).To(Succeed())
+			outputDir, err := os.MkdirTemp("", "libasciidoc-site")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, outputDir)
+			diagnostics, err := libasciidoc.ConvertDir(sourceDir, outputDir,
+				configuration.WithLastUpdated(lastUpdated),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diagnostics).To(BeEmpty())
+			index, err := os.ReadFile(filepath.Join(outputDir, "index.html"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(index)).To(ContainSubstring(`<p>See <a href="sub/page.htm">the page</a>.</p>`))
+			page, err := os.ReadFile(filepath.Join(outputDir, "sub", "page.htm"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(page)).To(ContainSubstring(`<p>Back to <a href="../index.html">the home page</a>.</p>`))
+		})
+
+		It("should name the manpages after their title", func() {
+			outputDir, err := os.MkdirTemp("", "libasciidoc-site")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, outputDir)
+			_, err = libasciidoc.ConvertDir("test/site", outputDir,
+				configuration.WithLastUpdated(lastUpdated),
+				configuration.WithBackEnd("manpage"),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filepath.Join(outputDir, "Home.1")).To(BeAnExistingFile())
+			Expect(filepath.Join(outputDir, "guide", "Installation.1")).To(BeAnExistingFile())
+		})
+	})
+
+	Context("extensions", func() {
+
+		lastUpdated := time.Now()
+
+		It("should apply preprocessors, tree processors and postprocessors", func() {
+			source := `== Section
+
+a paragraph with {product}`
+			out := &strings.Builder{}
+			_, err := libasciidoc.Convert(
+				strings.NewReader(source),
+				out,
+				configuration.NewConfiguration(
+					configuration.WithFilename("test.adoc"),
+					configuration.WithLastUpdated(lastUpdated),
+					configuration.WithHeaderFooter(false),
+					configuration.WithPreprocessor(func(source string) (string, error) {
+						return ":product: libasciidoc\n\n" + source, nil
+					}),
+					configuration.WithTreeProcessor(func(doc *types.Document) error {
+						// uppercase all the text
+						return types.Walk(doc, types.VisitorFuncs{
+							EnterFunc: func(c *types.Cursor) error {
+								if s, ok := c.Element().(*types.StringElement); ok {
+									c.Replace(&types.StringElement{Content: strings.ToUpper(s.Content)})
+								}
+								return nil
+							},
+						})
+					}),
+					configuration.WithPostprocessor(func(output string) (string, error) {
+						return strings.ReplaceAll(output, "<p>", `<p class="text">`), nil
+					}),
+				))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.String()).To(MatchHTML(`<div class="sect1">
+<h2 id="_section">SECTION</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p class="text">A PARAGRAPH WITH LIBASCIIDOC</p>
+</div>
+</div>
+</div>
+`))
+		})
+
+		It("should insert docinfo content", func() {
+			source := `= Title`
+			out := &strings.Builder{}
+			_, err := libasciidoc.Convert(
+				strings.NewReader(source),
+				out,
+				configuration.NewConfiguration(
+					configuration.WithFilename("test.adoc"),
+					configuration.WithLastUpdated(lastUpdated),
+					configuration.WithHeaderFooter(true),
+					configuration.WithUnsetAttribute(types.AttrStylesheet),
+					configuration.WithDocinfoProcessor(configuration.DocinfoHead, func(doc *types.Document) (string, error) {
+						return `<meta name="robots" content="noindex">` + "\n", nil
+					}),
+					configuration.WithDocinfoProcessor(configuration.DocinfoFooter, func(doc *types.Document) (string, error) {
+						return `<script src="analytics.js"></script>`, nil
+					}),
+				))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.String()).To(MatchHTMLTemplate(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>Title</title>
+<meta name="robots" content="noindex">
+</head>
+<body class="article">
+<div id="header">
+<h1>Title</h1>
+</div>
+<div id="content">
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{ .LastUpdated }}
+</div>
+</div>
+<script src="analytics.js"></script>
+</body>
+</html>
+`, struct {
+				LastUpdated string
+			}{
+				LastUpdated: lastUpdated.Format(configuration.LastUpdatedFormat),
+			}))
+		})
+
+		It("should fail when tree processor fails", func() {
+			_, err := libasciidoc.Convert(
+				strings.NewReader(`a paragraph`),
+				&strings.Builder{},
+				configuration.NewConfiguration(
+					configuration.WithFilename("test.adoc"),
+					configuration.WithTreeProcessor(func(doc *types.Document) error {
+						return fmt.Errorf("invalid document")
+					}),
+				))
+			Expect(err).To(MatchError("error while processing the document: invalid document"))
+		})
+
+		It("should apply block processors", func() {
+			source := `[plantuml]
+----
+Alice -> Bob: hello
+----
+
+[mermaid]
+graph TD; A-->B;
+
+[comment]
+a paragraph to remove`
+			contents := []string{}
+			out := &strings.Builder{}
+			_, err := libasciidoc.Convert(
+				strings.NewReader(source),
+				out,
+				configuration.NewConfiguration(
+					configuration.WithFilename("test.adoc"),
+					configuration.WithHeaderFooter(false),
+					configuration.WithBlockProcessor("plantuml", func(block types.WithElements, content string) (interface{}, error) {
+						contents = append(contents, content)
+						return &types.ImageBlock{
+							Location: &types.Location{
+								Path: "diagram.svg",
+							},
+						}, nil
+					}),
+					configuration.WithBlockProcessor("mermaid", func(block types.WithElements, content string) (interface{}, error) {
+						contents = append(contents, content)
+						return &types.DelimitedBlock{
+							Kind: types.Passthrough,
+							Elements: []interface{}{
+								&types.StringElement{Content: `<pre class="mermaid">` + content + `</pre>`},
+							},
+						}, nil
+					}),
+					configuration.WithBlockProcessor("comment", func(block types.WithElements, content string) (interface{}, error) {
+						return nil, nil
+					}),
+				))
+			Expect(err).NotTo(HaveOccurred())
+			// content is given without any substitution
+			Expect(contents).To(Equal([]string{
+				"Alice -> Bob: hello",
+				"graph TD; A-->B;",
+			}))
+			Expect(out.String()).To(MatchHTML(`<div class="imageblock">
+<div class="content">
+<img src="diagram.svg" alt="diagram">
+</div>
+</div>
+<pre class="mermaid">graph TD; A-->B;</pre>
+`))
+		})
+
+		It("should apply block processors on compound blocks", func() {
+			source := `[mermaid]
+____
+quote *bold* {doctitle}
+____
+
+[mermaid]
+====
+first *line*
+
+second line
+====`
+			contents := []string{}
+			out := &strings.Builder{}
+			_, err := libasciidoc.Convert(
+				strings.NewReader(source),
+				out,
+				configuration.NewConfiguration(
+					configuration.WithFilename("test.adoc"),
+					configuration.WithHeaderFooter(false),
+					configuration.WithBlockProcessor("mermaid", func(block types.WithElements, content string) (interface{}, error) {
+						contents = append(contents, content)
+						return nil, nil
+					}),
+				))
+			Expect(err).NotTo(HaveOccurred())
+			// content is given without any parsing nor substitution
+			Expect(contents).To(Equal([]string{
+				"quote *bold* {doctitle}",
+				"first *line*\n\nsecond line",
+			}))
+			Expect(out.String()).To(BeEmpty())
+		})
+
+		It("should apply macro processors", func() {
+			source := `gist::1234[]
+
+see issue:42[the issue] for more details
+
+== About issue:43[#43]`
+			out := &strings.Builder{}
+			_, err := libasciidoc.Convert(
+				strings.NewReader(source),
+				out,
+				configuration.NewConfiguration(
+					configuration.WithFilename("test.adoc"),
+					configuration.WithHeaderFooter(false),
+					configuration.WithMacroProcessor("gist", func(macro *types.UserMacro) (interface{}, error) {
+						return &types.Paragraph{
+							Elements: []interface{}{
+								&types.StringElement{Content: "gist #" + macro.Value},
+							},
+						}, nil
+					}),
+					configuration.WithMacroProcessor("issue", func(macro *types.UserMacro) (interface{}, error) {
+						return &types.InlineLink{
+							Location: &types.Location{
+								Scheme: "https://",
+								Path:   "example.com/issues/" + macro.Value,
+							},
+							Attributes: types.Attributes{
+								types.AttrInlineLinkText: macro.Attributes.GetAsStringWithDefault(types.AttrPositional1, ""),
+							},
+						}, nil
+					}),
+				))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.String()).To(MatchHTML(`<div class="paragraph">
+<p>gist #1234</p>
+</div>
+<div class="paragraph">
+<p>see <a href="https://example.com/issues/42">the issue</a> for more details</p>
+</div>
+<div class="sect1">
+<h2 id="_about_httpsexample_comissues43">About <a href="https://example.com/issues/43">#43</a></h2>
+<div class="sectionbody">
+</div>
+</div>
+`))
+		})
+
+		It("should skip block when macro processor fails", func() {
+			source := `gist::1234[]
+
+a paragraph`
+			out := &strings.Builder{}
+			_, err := libasciidoc.Convert(
+				strings.NewReader(source),
+				out,
+				configuration.NewConfiguration(
+					configuration.WithFilename("test.adoc"),
+					configuration.WithHeaderFooter(false),
+					configuration.WithMacroProcessor("gist", func(macro *types.UserMacro) (interface{}, error) {
+						return nil, fmt.Errorf("unknown gist")
+					}),
+				))
+			// same as with a parsing error: the error is logged and the block is skipped
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.String()).To(MatchHTML(`<div class="paragraph">
+<p>a paragraph</p>
+</div>
+`))
+		})
+	})
 })
+
+var _ = DescribeTable("output file suffix",
+
+	func(backend, suffix string) {
+		Expect(libasciidoc.OutfileSuffix(backend)).To(Equal(suffix))
+	},
+
+	Entry("html5", "html5", ".html"),
+	Entry("xhtml5", "xhtml5", ".html"),
+	Entry("docbook5", "docbook5", ".xml"),
+	Entry("manpage", "manpage", ".man"),
+)
@@ -0,0 +1,339 @@
+// Package bibtex reads the entries of bibliographies in the BibTeX format,
+// so that they can be cited in documents with the `cite:[]` and `citenp:[]` inline macros.
+package bibtex
+
+import (
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// Entry an entry of a bibliography (eg: a book or an article)
+type Entry struct {
+	Type   string            // the type of entry, in lowercase (eg: `book`, `article`, etc.)
+	Key    string            // the citation key
+	Fields map[string]string // the fields of the entry, with their name in lowercase
+}
+
+// Authors returns the names of the authors of this entry (or of its editors if it has no author)
+func (e *Entry) Authors() []string {
+	names := e.Fields["author"]
+	if names == "" {
+		names = e.Fields["editor"]
+	}
+	if names == "" {
+		return nil
+	}
+	result := []string{}
+	for _, name := range splitNames(names) {
+		if name = strings.TrimSpace(name); name != "" {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// splits the given names on the ` and ` separator (case insensitive)
+func splitNames(names string) []string {
+	result := []string{}
+	words := strings.Fields(names)
+	name := []string{}
+	for _, w := range words {
+		if strings.EqualFold(w, "and") {
+			result = append(result, strings.Join(name, " "))
+			name = []string{}
+			continue
+		}
+		name = append(name, w)
+	}
+	return append(result, strings.Join(name, " "))
+}
+
+// LastName returns the last name of the given author
+// (ie, the part before the comma in `Lane, John`, or the last word in `John Lane`)
+func LastName(author string) string {
+	if i := strings.Index(author, ","); i >= 0 {
+		return strings.TrimSpace(author[:i])
+	}
+	words := strings.Fields(author)
+	if len(words) == 0 {
+		return ""
+	}
+	return words[len(words)-1]
+}
+
+// Year returns the year of publication of this entry
+func (e *Entry) Year() string {
+	return e.Fields["year"]
+}
+
+// Title returns the title of this entry
+func (e *Entry) Title() string {
+	return e.Fields["title"]
+}
+
+// ReadFile reads the entries of the BibTeX file at the given path
+func ReadFile(path string) ([]*Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read bibliography")
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// Read reads the entries of the given BibTeX content.
+// `@string` macros are substituted in the field values, while `@comment` and `@preamble` entries are ignored
+func Read(r io.Reader) ([]*Entry, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read bibliography")
+	}
+	s := &scanner{
+		content: []rune(string(content)),
+		strings: map[string]string{},
+	}
+	return s.entries()
+}
+
+var months = map[string]string{
+	"jan": "January",
+	"feb": "February",
+	"mar": "March",
+	"apr": "April",
+	"may": "May",
+	"jun": "June",
+	"jul": "July",
+	"aug": "August",
+	"sep": "September",
+	"oct": "October",
+	"nov": "November",
+	"dec": "December",
+}
+
+type scanner struct {
+	content []rune
+	pos     int
+	strings map[string]string // values of the `@string` macros
+}
+
+func (s *scanner) entries() ([]*Entry, error) {
+	result := []*Entry{}
+	for {
+		// anything outside of an entry is a comment
+		for s.pos < len(s.content) && s.content[s.pos] != '@' {
+			s.pos++
+		}
+		if s.pos >= len(s.content) {
+			return result, nil
+		}
+		s.pos++ // skip '@'
+		kind := strings.ToLower(s.identifier())
+		s.skipSpaces()
+		closing := '}'
+		if s.accept('(') {
+			closing = ')'
+		} else if !s.accept('{') {
+			return nil, s.errorf("expected '{' or '(' after '@%s'", kind)
+		}
+		switch kind {
+		case "comment", "preamble":
+			if err := s.skipBalanced(closing); err != nil {
+				return nil, err
+			}
+		case "string":
+			name, value, err := s.field()
+			if err != nil {
+				return nil, err
+			}
+			s.strings[name] = value
+			s.skipSpaces()
+			if !s.accept('}') && !s.accept(')') {
+				return nil, s.errorf("expected end of '@string' entry")
+			}
+		default:
+			e, err := s.entry(kind)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, e)
+		}
+	}
+}
+
+func (s *scanner) entry(kind string) (*Entry, error) {
+	s.skipSpaces()
+	start := s.pos
+	for s.pos < len(s.content) && s.content[s.pos] != ',' && s.content[s.pos] != '}' && s.content[s.pos] != ')' {
+		s.pos++
+	}
+	e := &Entry{
+		Type:   kind,
+		Key:    strings.TrimSpace(string(s.content[start:s.pos])),
+		Fields: map[string]string{},
+	}
+	if e.Key == "" {
+		return nil, s.errorf("missing key in '@%s' entry", kind)
+	}
+	for {
+		s.skipSpaces()
+		if s.accept('}') || s.accept(')') {
+			return e, nil
+		}
+		if !s.accept(',') {
+			return nil, s.errorf("expected ',' or end of entry '%s'", e.Key)
+		}
+		s.skipSpaces()
+		if s.accept('}') || s.accept(')') { // trailing comma
+			return e, nil
+		}
+		name, value, err := s.field()
+		if err != nil {
+			return nil, err
+		}
+		e.Fields[name] = value
+	}
+}
+
+// field reads a `name = value` field, in which the value can be made of multiple parts
+// concatenated with `#`
+func (s *scanner) field() (string, string, error) {
+	s.skipSpaces()
+	name := strings.ToLower(s.identifier())
+	if name == "" {
+		return "", "", s.errorf("expected field name")
+	}
+	s.skipSpaces()
+	if !s.accept('=') {
+		return "", "", s.errorf("expected '=' after field '%s'", name)
+	}
+	value := &strings.Builder{}
+	for {
+		s.skipSpaces()
+		if s.pos >= len(s.content) {
+			return "", "", s.errorf("unexpected end of content in field '%s'", name)
+		}
+		switch c := s.content[s.pos]; {
+		case c == '{':
+			s.pos++
+			start := s.pos
+			if err := s.skipBalanced('}'); err != nil {
+				return "", "", err
+			}
+			value.WriteString(string(s.content[start : s.pos-1]))
+		case c == '"':
+			s.pos++
+			start := s.pos
+			depth := 0
+			for s.pos < len(s.content) && (s.content[s.pos] != '"' || depth > 0) {
+				switch s.content[s.pos] {
+				case '{':
+					depth++
+				case '}':
+					depth--
+				}
+				s.pos++
+			}
+			if s.pos >= len(s.content) {
+				return "", "", s.errorf("unclosed quoted value in field '%s'", name)
+			}
+			value.WriteString(string(s.content[start:s.pos]))
+			s.pos++
+		default:
+			// number or macro
+			v := s.identifier()
+			if v == "" {
+				return "", "", s.errorf("invalid value in field '%s'", name)
+			}
+			if m, found := s.strings[strings.ToLower(v)]; found {
+				v = m
+			} else if m, found := months[strings.ToLower(v)]; found {
+				v = m
+			}
+			value.WriteString(v)
+		}
+		s.skipSpaces()
+		if !s.accept('#') {
+			return name, clean(value.String()), nil
+		}
+	}
+}
+
+func (s *scanner) identifier() string {
+	start := s.pos
+	for s.pos < len(s.content) {
+		c := s.content[s.pos]
+		if unicode.IsSpace(c) || strings.ContainsRune(`{}(),=#"@`, c) {
+			break
+		}
+		s.pos++
+	}
+	return string(s.content[start:s.pos])
+}
+
+// skips the content until the closing brace (or parenthesis) of the current group
+func (s *scanner) skipBalanced(closing rune) error {
+	opening := '{'
+	if closing == ')' {
+		opening = '('
+	}
+	depth := 1
+	for s.pos < len(s.content) {
+		switch s.content[s.pos] {
+		case opening:
+			depth++
+		case closing:
+			depth--
+		}
+		s.pos++
+		if depth == 0 {
+			return nil
+		}
+	}
+	return s.errorf("unclosed group")
+}
+
+func (s *scanner) skipSpaces() {
+	for s.pos < len(s.content) && unicode.IsSpace(s.content[s.pos]) {
+		s.pos++
+	}
+}
+
+func (s *scanner) accept(c rune) bool {
+	if s.pos < len(s.content) && s.content[s.pos] == c {
+		s.pos++
+		return true
+	}
+	return false
+}
+
+func (s *scanner) errorf(format string, args ...interface{}) error {
+	line := 1
+	for _, c := range s.content[:s.pos] {
+		if c == '\n' {
+			line++
+		}
+	}
+	return errors.Errorf("invalid bibliography at line %d: "+format, append([]interface{}{line}, args...)...)
+}
+
+var latexReplacer = strings.NewReplacer(
+	`\&`, "&",
+	`\%`, "%",
+	`\$`, "$",
+	`\_`, "_",
+	`\#`, "#",
+	"---", "—",
+	"--", "–",
+	"{", "",
+	"}", "",
+)
+
+// clean removes the braces (used to protect the case of words) and the escape sequences of the given value,
+// and collapses its whitespaces
+func clean(value string) string {
+	return strings.Join(strings.Fields(latexReplacer.Replace(value)), " ")
+}
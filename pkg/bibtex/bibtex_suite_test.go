@@ -0,0 +1,13 @@
+package bibtex_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBibTeX(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "BibTeX Suite")
+}
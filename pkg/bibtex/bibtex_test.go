@@ -0,0 +1,92 @@
+package bibtex_test
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/bibtex"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("read BibTeX entries", func() {
+
+	It("should read entries with braced, quoted and numeric values", func() {
+		source := `% a comment
+@Book{hunt2000,
+  author    = {Andrew Hunt and David Thomas},
+  title     = {The {Pragmatic} Programmer},
+  publisher = "Addison-Wesley",
+  year      = 2000,
+}
+
+@article(lane2001,
+  AUTHOR = "Lane, John",
+  title = {Go \& Friends},
+  journal = {Journal of Code},
+  year = {2001}
+)`
+		entries, err := bibtex.Read(strings.NewReader(source))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(Equal([]*bibtex.Entry{
+			{
+				Type: "book",
+				Key:  "hunt2000",
+				Fields: map[string]string{
+					"author":    "Andrew Hunt and David Thomas",
+					"title":     "The Pragmatic Programmer",
+					"publisher": "Addison-Wesley",
+					"year":      "2000",
+				},
+			},
+			{
+				Type: "article",
+				Key:  "lane2001",
+				Fields: map[string]string{
+					"author":  "Lane, John",
+					"title":   "Go & Friends",
+					"journal": "Journal of Code",
+					"year":    "2001",
+				},
+			},
+		}))
+	})
+
+	It("should substitute string macros and months", func() {
+		source := `@string{acm = "ACM Press"}
+@comment{ignored {entry}}
+@inproceedings{doe1999,
+  author = {Jane Doe},
+  publisher = acm # { (New York)},
+  month = jan,
+  year = 1999
+}`
+		entries, err := bibtex.Read(strings.NewReader(source))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Fields).To(Equal(map[string]string{
+			"author":    "Jane Doe",
+			"publisher": "ACM Press (New York)",
+			"month":     "January",
+			"year":      "1999",
+		}))
+	})
+
+	It("should fail on unclosed entry", func() {
+		source := `@book{hunt2000,
+  title = {The Pragmatic Programmer`
+		_, err := bibtex.Read(strings.NewReader(source))
+		Expect(err).To(MatchError("invalid bibliography at line 2: unclosed group"))
+	})
+
+	It("should return the authors and their last names", func() {
+		e := &bibtex.Entry{
+			Fields: map[string]string{
+				"author": "Andrew Hunt AND Thomas, David",
+			},
+		}
+		Expect(e.Authors()).To(Equal([]string{"Andrew Hunt", "Thomas, David"}))
+		Expect(bibtex.LastName(e.Authors()[0])).To(Equal("Hunt"))
+		Expect(bibtex.LastName(e.Authors()[1])).To(Equal("Thomas"))
+	})
+})
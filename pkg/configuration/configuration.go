@@ -1,8 +1,11 @@
 package configuration
 
 import (
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/bytesparadise/libasciidoc/pkg/diagram"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/davecgh/go-spew/spew"
 	log "github.com/sirupsen/logrus"
@@ -14,11 +17,18 @@ func NewConfiguration(settings ...Setting) *Configuration {
 		Attributes: map[string]interface{}{
 			"basebackend-html": true, // along with default backend, to support `ifdef::basebackend-html` conditionals out-of-the-box
 		},
-		BackEnd: "html5", // default backend
-		Macros:  map[string]MacroTemplate{},
+		SoftAttributes:  types.Attributes{},
+		UnsetAttributes: map[string]bool{},
+		BackEnd:         "html5", // default backend
+		Macros:          map[string]MacroTemplate{},
+		DiagramConverters: map[string]diagram.Converter{
+			"graphviz": diagram.Graphviz,
+			"dot":      diagram.Graphviz,
+		},
 	}
 	// default backed
 	WithBackEnd("html5")(config)
+	WithSafeMode(Unsafe)(config)
 	// custom settings
 	for _, set := range settings {
 		set(config)
@@ -31,13 +41,24 @@ func NewConfiguration(settings ...Setting) *Configuration {
 
 // Configuration the configuration used when rendering a document
 type Configuration struct {
-	Filename              string // TODO: move out of Configuration?
-	Attributes            types.Attributes
+	Filename              string           // TODO: move out of Configuration?
+	Attributes            types.Attributes // the attributes set with the CLI or the API, which cannot be changed (nor unset) in the document
+	SoftAttributes        types.Attributes // the attributes soft-set with the CLI or the API, which can be changed (or unset) in the document
+	UnsetAttributes       map[string]bool  // the attributes unset with the CLI or the API, which cannot be set in the document
 	LastUpdated           time.Time
 	WrapInHTMLBodyElement bool // flag to include the content in an html>body element
 	CSS                   []string
+	EmbedAssets           bool     // flag to embed the stylesheets, images and icons in the document, so that it is self-contained
+	OutputDir             string   // the directory of the output document, in which the linked stylesheet is copied (optional)
+	BaseDir               string   // the directory in which the relative paths to the resources are resolved (default: the directory of the document)
+	SafeMode              SafeMode // the restriction of the access to the files and the resources of the document
 	BackEnd               string
+	TemplateDir           string // the directory of the templates which override the templates of the backend (optional)
 	Macros                map[string]MacroTemplate
+	Extensions            Extensions                   // the extensions called during the conversion of the document (optional)
+	DiagramConverters     map[string]diagram.Converter // the converters of the diagrams, indexed by kind of diagram (eg: `graphviz`)
+	Diagnostics           *types.Diagnostics           // collects the problems reported while processing the document (optional)
+	Catalog               *types.Catalog               // the other documents converted along with this one, to resolve the cross references to them (optional)
 }
 
 const (
@@ -69,13 +90,75 @@ func WithAttributes(attrs map[string]interface{}) Setting {
 	}
 }
 
-// WithAttribute function to set an attribute as if it was passed as an argument in the CLI
+// WithAttribute function to set an attribute as if it was passed as an argument in the CLI.
+// The attribute is locked, ie, it cannot be changed (nor unset) in the document.
 func WithAttribute(key string, value interface{}) Setting {
 	return func(config *Configuration) {
+		delete(config.SoftAttributes, key)
+		delete(config.UnsetAttributes, key)
 		config.Attributes[key] = value
 	}
 }
 
+// WithSoftAttribute function to soft-set an attribute, ie, to set its default value, which can be changed (or unset) in the document
+func WithSoftAttribute(key string, value interface{}) Setting {
+	return func(config *Configuration) {
+		delete(config.Attributes, key)
+		delete(config.UnsetAttributes, key)
+		config.SoftAttributes[key] = value
+	}
+}
+
+// WithUnsetAttribute function to unset an attribute, which is locked, ie, it cannot be set in the document
+func WithUnsetAttribute(key string) Setting {
+	return func(config *Configuration) {
+		delete(config.Attributes, key)
+		delete(config.SoftAttributes, key)
+		config.UnsetAttributes[key] = true
+	}
+}
+
+// WithAttributeOverride function to set, soft-set or unset an attribute, given in the same form as in the CLI:
+// `name` or `name=value` to set the attribute, `name@` or `name=value@` to soft-set it, `name!` or `!name` to unset it
+// (and `name!@` to soft-unset it, ie, to unset it without locking it)
+func WithAttributeOverride(override string) Setting {
+	return func(config *Configuration) {
+		soft := strings.HasSuffix(override, "@")
+		override = strings.TrimSuffix(override, "@")
+		kv := strings.SplitN(override, "=", 2)
+		key := strings.TrimSuffix(kv[0], "@") // also support `name@=value`
+		if len(kv) == 1 && (strings.HasPrefix(key, "!") || strings.HasSuffix(key, "!")) {
+			key = strings.TrimSuffix(strings.TrimPrefix(key, "!"), "!")
+			if soft {
+				delete(config.Attributes, key)
+				delete(config.SoftAttributes, key)
+				delete(config.UnsetAttributes, key)
+				return
+			}
+			WithUnsetAttribute(key)(config)
+			return
+		}
+		var value interface{} = ""
+		if len(kv) == 2 {
+			value = kv[1]
+		}
+		if soft || strings.HasSuffix(kv[0], "@") {
+			WithSoftAttribute(key, value)(config)
+			return
+		}
+		WithAttribute(key, value)(config)
+	}
+}
+
+// WithAttributeOverrides function to set, soft-set or unset the given attributes (see `WithAttributeOverride`)
+func WithAttributeOverrides(overrides []string) Setting {
+	return func(config *Configuration) {
+		for _, o := range overrides {
+			WithAttributeOverride(o)(config)
+		}
+	}
+}
+
 // WithHeaderFooter function to set the `include header/footer` setting in the config
 func WithHeaderFooter(value bool) Setting {
 	return func(config *Configuration) {
@@ -90,23 +173,119 @@ func WithCSS(hrefs []string) Setting {
 	}
 }
 
-// WithBackEnd sets the backend format, valid values are "html", "html5", "xhtml", "xhtml5", and "" (defaults to html5)
-func WithBackEnd(backend string) Setting {
+// WithStylesheet function to set the `stylesheet` attribute, ie, the path to the custom stylesheet of the document
+// (relative to the `stylesdir` directory), which replaces the default stylesheet
+func WithStylesheet(path string) Setting {
 	return func(config *Configuration) {
-		config.Attributes.Set("backend", backend)
-		switch backend {
-		case "html", "html5", "xhtml", "xhtml5":
-			config.Attributes.Set("basebackend-html", true)
-		default:
-			config.Attributes.Unset("basebackend-html")
+		config.Attributes[types.AttrStylesheet] = path
+	}
+}
+
+// WithStylesDir function to set the `stylesdir` attribute, ie, the directory of the stylesheet
+func WithStylesDir(dir string) Setting {
+	return func(config *Configuration) {
+		config.Attributes[types.AttrStylesDir] = dir
+	}
+}
+
+// WithLinkCSS function to set the `linkcss` attribute, to link the stylesheet to the document instead of embedding it
+func WithLinkCSS(value bool) Setting {
+	return func(config *Configuration) {
+		if value {
+			config.Attributes[types.AttrLinkCSS] = ""
+		} else {
+			config.Attributes.Unset(types.AttrLinkCSS)
+		}
+	}
+}
+
+// WithCopyCSS function to set the `copycss` attribute, to copy the linked stylesheet in the output directory
+func WithCopyCSS(value bool) Setting {
+	return func(config *Configuration) {
+		if value {
+			config.Attributes[types.AttrCopyCSS] = ""
+		} else {
+			config.Attributes.Unset(types.AttrCopyCSS)
 		}
+	}
+}
+
+// WithOutputDir function to set the `output dir` setting in the config, ie, the directory of the output document
+func WithOutputDir(dir string) Setting {
+	return func(config *Configuration) {
+		config.OutputDir = dir
+	}
+}
+
+// WithEmbedAssets function to set the `embed assets` setting in the config, to embed the local stylesheets (`css` setting),
+// images and icon images in the document
+func WithEmbedAssets(value bool) Setting {
+	return func(config *Configuration) {
+		config.EmbedAssets = value
+	}
+}
+
+// WithTemplateDir function to set the `template dir` setting in the config, ie, the directory of the templates
+// which override the templates of the backend. Each template is a file named after a field of `sgml.Templates` (eg: `Paragraph.tmpl`)
+func WithTemplateDir(dir string) Setting {
+	return func(config *Configuration) {
+		config.TemplateDir = dir
+	}
+}
+
+// WithBackEnd sets the backend format, valid values are "html", "html5", "xhtml", "xhtml5", "docbook", "docbook5", "manpage" and "" (defaults to html5)
+func WithBackEnd(backend string) Setting {
+	return func(config *Configuration) {
+		config.Attributes.Set(types.AttrBackEnd, backend)
 		config.BackEnd = backend
+		config.Attributes.Unset("basebackend-html")
+		config.Attributes.Unset("basebackend-docbook")
+		config.Attributes.Unset("basebackend-manpage")
 		switch backend {
 		case "html", "html5", "xhtml", "xhtml5":
+			config.Attributes.Set(types.AttrBaseBackEnd, "html")
 			config.Attributes.Set("basebackend-html", true)
+		case "docbook", "docbook5":
+			config.Attributes.Set(types.AttrBaseBackEnd, "docbook")
+			config.Attributes.Set("basebackend-docbook", true)
+		case "manpage":
+			config.Attributes.Set(types.AttrBaseBackEnd, "manpage")
+			config.Attributes.Set("basebackend-manpage", true)
+			// the `manpage` backend implies the `manpage` doctype, unless specified otherwise (including in the document)
+			if !config.Attributes.Has(types.AttrDocType) {
+				config.SoftAttributes.Set(types.AttrDocType, "manpage")
+			}
 		default:
-			config.Attributes.Unset("basebackend-html")
+			config.Attributes.Unset(types.AttrBaseBackEnd)
+		}
+	}
+}
+
+// WithDocType function to set the `doctype` attribute (eg: `book`), which cannot be changed in the document
+func WithDocType(doctype string) Setting {
+	return WithAttribute(types.AttrDocType, doctype)
+}
+
+// WithBaseDir function to set the `base dir` setting in the config, ie, the directory in which the relative paths
+// to the resources of the document (images, stylesheets, etc.) are resolved, instead of the directory of the document.
+// Also, when the document is read from a stream (eg: STDIN), the relative paths in the file inclusions are resolved in this directory
+func WithBaseDir(dir string) Setting {
+	return func(config *Configuration) {
+		config.BaseDir = dir
+	}
+}
+
+// WithSafeMode function to set the `safe mode` setting in the config, along with the `safe-mode-name`, `safe-mode-level`
+// and `safe-mode-<name>` attributes
+func WithSafeMode(mode SafeMode) Setting {
+	return func(config *Configuration) {
+		config.SafeMode = mode
+		for _, name := range safeModeNames {
+			delete(config.Attributes, "safe-mode-"+name)
 		}
+		config.Attributes[types.AttrSafeModeName] = mode.String()
+		config.Attributes[types.AttrSafeModeLevel] = strconv.Itoa(int(mode))
+		config.Attributes["safe-mode-"+mode.String()] = ""
 	}
 }
 
@@ -124,3 +303,33 @@ func WithMacroTemplate(name string, t MacroTemplate) Setting {
 		config.Macros[name] = t
 	}
 }
+
+// WithDiagramConverter function to register the converter of the diagrams of the given kind
+// (eg: `plantuml` for the `[plantuml]` blocks and the `plantuml::file.puml[]` block macros),
+// or to unregister the current converter if the given one is `nil`
+func WithDiagramConverter(kind string, c diagram.Converter) Setting {
+	return func(config *Configuration) {
+		if c == nil {
+			delete(config.DiagramConverters, kind)
+			return
+		}
+		if config.DiagramConverters == nil {
+			config.DiagramConverters = map[string]diagram.Converter{}
+		}
+		config.DiagramConverters[kind] = c
+	}
+}
+
+// WithDiagnostics function to set the collector of the diagnostics reported while processing the document
+func WithDiagnostics(d *types.Diagnostics) Setting {
+	return func(config *Configuration) {
+		config.Diagnostics = d
+	}
+}
+
+// WithCatalog function to set the catalog of the documents converted together, to resolve the cross references between them
+func WithCatalog(c *types.Catalog) Setting {
+	return func(config *Configuration) {
+		config.Catalog = c
+	}
+}
@@ -0,0 +1,108 @@
+package configuration
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// Preprocessor an extension which transforms the source of the document before it is parsed,
+// ie, before the file inclusions and the conditionals are processed.
+// Note: the source positions reported in the diagnostics refer to the transformed source.
+type Preprocessor func(source string) (string, error)
+
+// TreeProcessor an extension which inspects or transforms the parsed document before it is rendered
+// (see `types.Walk` to traverse and transform the elements of the document)
+type TreeProcessor func(doc *types.Document) error
+
+// Postprocessor an extension which transforms the output of the renderer (eg: the whole HTML document)
+type Postprocessor func(output string) (string, error)
+
+// DocinfoProcessor an extension which returns the content to insert in the output document, at the location
+// it was registered for (eg: some `<meta>` or `<script>` elements at the end of the `<head>` element of an HTML document)
+type DocinfoProcessor func(doc *types.Document) (string, error)
+
+// BlockProcessor an extension which processes the paragraphs and the delimited blocks with a given style (eg: `[plantuml]`),
+// and returns the element which replaces the block in the document (eg: an image block), or `nil` to remove the block.
+// The given content is the text of the block, on which no substitution was applied in the case of the paragraphs and
+// the listing, literal, fenced and passthrough blocks (unless the block has a `subs` attribute).
+type BlockProcessor func(block types.WithElements, content string) (interface{}, error)
+
+// MacroProcessor an extension which processes the block and inline macros with a given name (eg: `gist::123[]`),
+// and returns the element which replaces the macro in the document (eg: a paragraph, an image block or a table for a
+// block macro, or an inline link for an inline macro), or `nil` to remove the macro.
+type MacroProcessor func(macro *types.UserMacro) (interface{}, error)
+
+// DocinfoLocation the location of the content returned by a DocinfoProcessor in the output document
+type DocinfoLocation string
+
+const (
+	// DocinfoHead the end of the `<head>` element
+	DocinfoHead DocinfoLocation = "head"
+	// DocinfoFooter the end of the `<body>` element
+	DocinfoFooter DocinfoLocation = "footer"
+)
+
+// Extensions the extensions which are called during the conversion of the document,
+// in the order in which they were registered
+type Extensions struct {
+	Preprocessors     []Preprocessor
+	TreeProcessors    []TreeProcessor
+	Postprocessors    []Postprocessor
+	DocinfoProcessors map[DocinfoLocation][]DocinfoProcessor
+	BlockProcessors   map[string]BlockProcessor // indexed by block style
+	MacroProcessors   map[string]MacroProcessor // indexed by macro name
+}
+
+// WithPreprocessor function to register a preprocessor, which transforms the source of the document before it is parsed
+func WithPreprocessor(p Preprocessor) Setting {
+	return func(config *Configuration) {
+		config.Extensions.Preprocessors = append(config.Extensions.Preprocessors, p)
+	}
+}
+
+// WithTreeProcessor function to register a tree processor, which transforms the parsed document before it is rendered
+func WithTreeProcessor(p TreeProcessor) Setting {
+	return func(config *Configuration) {
+		config.Extensions.TreeProcessors = append(config.Extensions.TreeProcessors, p)
+	}
+}
+
+// WithBlockProcessor function to register a block processor for the paragraphs and delimited blocks with the given style
+// (which replaces the processor previously registered for the same style, if any)
+func WithBlockProcessor(style string, p BlockProcessor) Setting {
+	return func(config *Configuration) {
+		if config.Extensions.BlockProcessors == nil {
+			config.Extensions.BlockProcessors = map[string]BlockProcessor{}
+		}
+		config.Extensions.BlockProcessors[style] = p
+	}
+}
+
+// WithMacroProcessor function to register a macro processor for the block and inline macros with the given name
+// (which replaces the processor previously registered for the same name, if any).
+// Macro processors take precedence over the macro templates (see `WithMacroTemplate`)
+func WithMacroProcessor(name string, p MacroProcessor) Setting {
+	return func(config *Configuration) {
+		if config.Extensions.MacroProcessors == nil {
+			config.Extensions.MacroProcessors = map[string]MacroProcessor{}
+		}
+		config.Extensions.MacroProcessors[name] = p
+	}
+}
+
+// WithPostprocessor function to register a postprocessor, which transforms the output of the renderer
+func WithPostprocessor(p Postprocessor) Setting {
+	return func(config *Configuration) {
+		config.Extensions.Postprocessors = append(config.Extensions.Postprocessors, p)
+	}
+}
+
+// WithDocinfoProcessor function to register a docinfo processor, whose content is inserted at the given location
+// of the full output document (ie, when the header and footer are included).
+func WithDocinfoProcessor(location DocinfoLocation, p DocinfoProcessor) Setting {
+	return func(config *Configuration) {
+		if config.Extensions.DocinfoProcessors == nil {
+			config.Extensions.DocinfoProcessors = map[DocinfoLocation][]DocinfoProcessor{}
+		}
+		config.Extensions.DocinfoProcessors[location] = append(config.Extensions.DocinfoProcessors[location], p)
+	}
+}
@@ -0,0 +1,107 @@
+package configuration
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// SafeMode the level of restriction of the access to the files and the resources of the document,
+// with the same levels as in Asciidoctor
+type SafeMode int
+
+const (
+	// Unsafe no restriction at all
+	Unsafe SafeMode = 0
+	// Safe the files outside of the base directory cannot be accessed
+	Safe SafeMode = 1
+	// Server same as `Safe`, with the document attributes which could compromise the server being locked
+	// (ie, they can only be set with the CLI or the API)
+	Server SafeMode = 10
+	// Secure same as `Server`, with the file inclusions being disabled and no file being read at all
+	Secure SafeMode = 20
+)
+
+var safeModeNames = map[SafeMode]string{
+	Unsafe: "unsafe",
+	Safe:   "safe",
+	Server: "server",
+	Secure: "secure",
+}
+
+// String returns the name of the safe mode (eg: `secure`)
+func (m SafeMode) String() string {
+	if name, found := safeModeNames[m]; found {
+		return name
+	}
+	return strconv.Itoa(int(m))
+}
+
+// ParseSafeMode returns the safe mode with the given name (or level)
+func ParseSafeMode(value string) (SafeMode, error) {
+	for m, name := range safeModeNames {
+		if strings.EqualFold(value, name) || value == strconv.Itoa(int(m)) {
+			return m, nil
+		}
+	}
+	return Unsafe, fmt.Errorf("invalid safe mode: '%s'", value)
+}
+
+// serverLockedAttributes the attributes which cannot be set in the document in the `server` and `secure` modes,
+// since they control the files which are read and written during the conversion
+var serverLockedAttributes = map[string]bool{
+	types.AttrCopyCSS:    true,
+	types.AttrStylesDir:  true,
+	types.AttrStylesheet: true,
+}
+
+// IsLocked returns true if the given attribute cannot be set or unset in the document in this safe mode
+func (m SafeMode) IsLocked(name string) bool {
+	return m >= Server && serverLockedAttributes[name]
+}
+
+// CheckAccess returns an error if the file at the given path cannot be read in this safe mode, ie, in the `secure` mode,
+// or if the file is outside of the given jail directory in the `safe` and `server` modes
+func (m SafeMode) CheckAccess(jail, path string) error {
+	switch {
+	case m >= Secure:
+		return fmt.Errorf("access to '%s' is restricted: files cannot be read in the '%s' safe mode", path, m)
+	case m >= Safe:
+		if !isWithin(realPath(jail), realPath(path)) {
+			return fmt.Errorf("access to '%s' is restricted: the file is outside of '%s' in the '%s' safe mode", path, jail, m)
+		}
+	}
+	return nil
+}
+
+// JailDir returns the absolute path to the directory outside of which the files cannot be read in the `safe` and `server` modes,
+// ie, the `base dir` if set, or the directory of the document (or the current directory if the document was read from a stream)
+func (c *Configuration) JailDir() string {
+	dir := c.BaseDir
+	if dir == "" {
+		dir = filepath.Dir(c.Filename)
+	}
+	if abs, err := filepath.Abs(dir); err == nil {
+		return abs
+	}
+	return dir
+}
+
+// realPath returns the absolute path of the given file, in which the symbolic links are evaluated (if the file exists)
+func realPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if p, err := filepath.EvalSymlinks(path); err == nil {
+		return p
+	}
+	return path
+}
+
+func isWithin(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,53 @@
+// Package diagram converts the source of the diagrams (eg: Graphviz, PlantUML or Ditaa diagrams) into images,
+// with the tools installed on the local machine.
+package diagram
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Kinds the kinds of diagrams which are recognized in the documents, in addition to the kinds
+// for which a converter is registered
+var Kinds = []string{"graphviz", "dot", "plantuml", "ditaa"}
+
+// Converter a function which converts the source of a diagram into an image of the given format (eg: `svg` or `png`)
+type Converter func(source, format string) ([]byte, error)
+
+// FormatPlaceholder the placeholder of the image format in the arguments of a `Command`
+const FormatPlaceholder = "{format}"
+
+// Graphviz converts the source of a Graphviz diagram with the local `dot` command
+var Graphviz = Command("dot", "-T"+FormatPlaceholder)
+
+// Command returns a converter which runs the given command (looked-up in the `PATH`), with the source of the diagram
+// as its standard input and the image as its standard output. The `{format}` placeholder in the arguments is
+// replaced with the format of the image (eg: `Command("plantuml", "-pipe", "-t{format}")`).
+func Command(name string, args ...string) Converter {
+	return func(source, format string) ([]byte, error) {
+		path, err := exec.LookPath(name)
+		if err != nil {
+			return nil, errors.Errorf("unable to convert diagram: '%s' command not found", name)
+		}
+		cmdArgs := make([]string, len(args))
+		for i, arg := range args {
+			cmdArgs[i] = strings.ReplaceAll(arg, FormatPlaceholder, format)
+		}
+		cmd := exec.Command(path, cmdArgs...) //nolint:gosec
+		cmd.Stdin = strings.NewReader(source)
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		cmd.Stdout = stdout
+		cmd.Stderr = stderr
+		if err := cmd.Run(); err != nil {
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return nil, errors.Wrapf(err, "unable to convert diagram with '%s': %s", name, msg)
+			}
+			return nil, errors.Wrapf(err, "unable to convert diagram with '%s'", name)
+		}
+		return stdout.Bytes(), nil
+	}
+}
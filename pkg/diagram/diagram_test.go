@@ -0,0 +1,28 @@
+package diagram_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/diagram"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("diagram converters", func() {
+
+	It("should convert with command", func() {
+		convert := diagram.Command("sh", "-c", "printf '<{format}>'; cat")
+		Expect(convert("a -> b", "svg")).To(Equal([]byte("<svg>a -> b")))
+	})
+
+	It("should fail when command is not found", func() {
+		convert := diagram.Command("unknown-diagram-command")
+		_, err := convert("a -> b", "svg")
+		Expect(err).To(MatchError("unable to convert diagram: 'unknown-diagram-command' command not found"))
+	})
+
+	It("should fail when command fails", func() {
+		convert := diagram.Command("sh", "-c", "echo 'syntax error' >&2; exit 1")
+		_, err := convert("a -> b", "svg")
+		Expect(err).To(MatchError("unable to convert diagram with 'sh': syntax error: exit status 1"))
+	})
+})
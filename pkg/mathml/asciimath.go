@@ -0,0 +1,626 @@
+package mathml
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// FromAsciiMath converts the given AsciiMath expression into a MathML `math` element,
+// displayed as a block (ie, on its own line) or inline
+func FromAsciiMath(source string, block bool) string {
+	p := &asciiMathParser{
+		input: source,
+	}
+	content, _ := p.parseExpression(false)
+	return math(content, block)
+}
+
+type asciiMathKind int
+
+const (
+	amConstant asciiMathKind = iota
+	amUnderOver
+	amUnary
+	amBinary
+	amLeftBracket
+	amRightBracket
+	amText
+	amNumber
+	amIdentifier
+	amOperator
+	amFraction
+	amSubscript
+	amSuperscript
+)
+
+type asciiMathSymbol struct {
+	kind   asciiMathKind
+	tag    string // `mi`, `mo` or `mtext` for constants
+	output string
+}
+
+var asciiMathSymbols = map[string]asciiMathSymbol{
+	// greek letters
+	"alpha":      {amConstant, "mi", "α"},
+	"beta":       {amConstant, "mi", "β"},
+	"chi":        {amConstant, "mi", "χ"},
+	"delta":      {amConstant, "mi", "δ"},
+	"Delta":      {amConstant, "mo", "Δ"},
+	"epsilon":    {amConstant, "mi", "ε"},
+	"epsi":       {amConstant, "mi", "ε"},
+	"varepsilon": {amConstant, "mi", "ɛ"},
+	"eta":        {amConstant, "mi", "η"},
+	"gamma":      {amConstant, "mi", "γ"},
+	"Gamma":      {amConstant, "mo", "Γ"},
+	"iota":       {amConstant, "mi", "ι"},
+	"kappa":      {amConstant, "mi", "κ"},
+	"lambda":     {amConstant, "mi", "λ"},
+	"Lambda":     {amConstant, "mo", "Λ"},
+	"lamda":      {amConstant, "mi", "λ"},
+	"Lamda":      {amConstant, "mo", "Λ"},
+	"mu":         {amConstant, "mi", "μ"},
+	"nu":         {amConstant, "mi", "ν"},
+	"omega":      {amConstant, "mi", "ω"},
+	"Omega":      {amConstant, "mo", "Ω"},
+	"phi":        {amConstant, "mi", "ϕ"},
+	"varphi":     {amConstant, "mi", "φ"},
+	"Phi":        {amConstant, "mo", "Φ"},
+	"pi":         {amConstant, "mi", "π"},
+	"Pi":         {amConstant, "mo", "Π"},
+	"psi":        {amConstant, "mi", "ψ"},
+	"Psi":        {amConstant, "mi", "Ψ"},
+	"rho":        {amConstant, "mi", "ρ"},
+	"sigma":      {amConstant, "mi", "σ"},
+	"Sigma":      {amConstant, "mo", "Σ"},
+	"tau":        {amConstant, "mi", "τ"},
+	"theta":      {amConstant, "mi", "θ"},
+	"vartheta":   {amConstant, "mi", "ϑ"},
+	"Theta":      {amConstant, "mo", "Θ"},
+	"upsilon":    {amConstant, "mi", "υ"},
+	"xi":         {amConstant, "mi", "ξ"},
+	"Xi":         {amConstant, "mo", "Ξ"},
+	"zeta":       {amConstant, "mi", "ζ"},
+	// binary operators
+	"+":        {amConstant, "mo", "+"},
+	"-":        {amConstant, "mo", "-"},
+	"*":        {amConstant, "mo", "⋅"},
+	"**":       {amConstant, "mo", "∗"},
+	"***":      {amConstant, "mo", "⋆"},
+	"//":       {amConstant, "mo", "/"},
+	"\\\\":     {amConstant, "mo", "\\"},
+	"setminus": {amConstant, "mo", "\\"},
+	"xx":       {amConstant, "mo", "×"},
+	"|><":      {amConstant, "mo", "⋉"},
+	"><|":      {amConstant, "mo", "⋊"},
+	"|><|":     {amConstant, "mo", "⋈"},
+	"-:":       {amConstant, "mo", "÷"},
+	"divide":   {amConstant, "mo", "÷"},
+	"@":        {amConstant, "mo", "∘"},
+	"o+":       {amConstant, "mo", "⊕"},
+	"ox":       {amConstant, "mo", "⊗"},
+	"o.":       {amConstant, "mo", "⊙"},
+	"^^":       {amConstant, "mo", "∧"},
+	"vv":       {amConstant, "mo", "∨"},
+	"nn":       {amConstant, "mo", "∩"},
+	"uu":       {amConstant, "mo", "∪"},
+	// large operators
+	"sum":  {amUnderOver, "mo", "∑"},
+	"prod": {amUnderOver, "mo", "∏"},
+	"^^^":  {amUnderOver, "mo", "⋀"},
+	"vvv":  {amUnderOver, "mo", "⋁"},
+	"nnn":  {amUnderOver, "mo", "⋂"},
+	"uuu":  {amUnderOver, "mo", "⋃"},
+	"lim":  {amUnderOver, "mo", "lim"},
+	"Lim":  {amUnderOver, "mo", "Lim"},
+	"min":  {amUnderOver, "mo", "min"},
+	"max":  {amUnderOver, "mo", "max"},
+	// relations
+	"=":    {amConstant, "mo", "="},
+	"!=":   {amConstant, "mo", "≠"},
+	":=":   {amConstant, "mo", ":="},
+	"<":    {amConstant, "mo", "<"},
+	"lt":   {amConstant, "mo", "<"},
+	"<=":   {amConstant, "mo", "≤"},
+	"lt=":  {amConstant, "mo", "≤"},
+	"le":   {amConstant, "mo", "≤"},
+	">":    {amConstant, "mo", ">"},
+	"gt":   {amConstant, "mo", ">"},
+	">=":   {amConstant, "mo", "≥"},
+	"gt=":  {amConstant, "mo", "≥"},
+	"ge":   {amConstant, "mo", "≥"},
+	"-<":   {amConstant, "mo", "≺"},
+	">-":   {amConstant, "mo", "≻"},
+	"-<=":  {amConstant, "mo", "⪯"},
+	">-=":  {amConstant, "mo", "⪰"},
+	"in":   {amConstant, "mo", "∈"},
+	"!in":  {amConstant, "mo", "∉"},
+	"sub":  {amConstant, "mo", "⊂"},
+	"sup":  {amConstant, "mo", "⊃"},
+	"sube": {amConstant, "mo", "⊆"},
+	"supe": {amConstant, "mo", "⊇"},
+	"-=":   {amConstant, "mo", "≡"},
+	"~=":   {amConstant, "mo", "≅"},
+	"~~":   {amConstant, "mo", "≈"},
+	"~":    {amConstant, "mo", "∼"},
+	"prop": {amConstant, "mo", "∝"},
+	// logical symbols
+	"and": {amConstant, "mtext", " and "},
+	"or":  {amConstant, "mtext", " or "},
+	"not": {amConstant, "mo", "¬"},
+	"=>":  {amConstant, "mo", "⇒"},
+	"if":  {amConstant, "mtext", " if "},
+	"<=>": {amConstant, "mo", "⇔"},
+	"AA":  {amConstant, "mo", "∀"},
+	"EE":  {amConstant, "mo", "∃"},
+	"_|_": {amConstant, "mo", "⊥"},
+	"TT":  {amConstant, "mo", "⊤"},
+	"|--": {amConstant, "mo", "⊢"},
+	"|==": {amConstant, "mo", "⊨"},
+	// miscellaneous symbols
+	"int":     {amConstant, "mo", "∫"},
+	"oint":    {amConstant, "mo", "∮"},
+	"del":     {amConstant, "mo", "∂"},
+	"partial": {amConstant, "mo", "∂"},
+	"grad":    {amConstant, "mo", "∇"},
+	"nabla":   {amConstant, "mo", "∇"},
+	"+-":      {amConstant, "mo", "±"},
+	"-+":      {amConstant, "mo", "∓"},
+	"O/":      {amConstant, "mo", "∅"},
+	"oo":      {amConstant, "mo", "∞"},
+	"aleph":   {amConstant, "mo", "ℵ"},
+	"...":     {amConstant, "mo", "..."},
+	":.":      {amConstant, "mo", "∴"},
+	":'":      {amConstant, "mo", "∵"},
+	"/_":      {amConstant, "mo", "∠"},
+	"/_\\":    {amConstant, "mo", "△"},
+	"'":       {amConstant, "mo", "′"},
+	"quad":    {amConstant, "mo", "  "},
+	"qquad":   {amConstant, "mo", "    "},
+	"cdots":   {amConstant, "mo", "⋯"},
+	"vdots":   {amConstant, "mo", "⋮"},
+	"ddots":   {amConstant, "mo", "⋱"},
+	"diamond": {amConstant, "mo", "⋄"},
+	"square":  {amConstant, "mo", "□"},
+	"|__":     {amConstant, "mo", "⌊"},
+	"__|":     {amConstant, "mo", "⌋"},
+	"|~":      {amConstant, "mo", "⌈"},
+	"~|":      {amConstant, "mo", "⌉"},
+	"CC":      {amConstant, "mo", "ℂ"},
+	"NN":      {amConstant, "mo", "ℕ"},
+	"QQ":      {amConstant, "mo", "ℚ"},
+	"RR":      {amConstant, "mo", "ℝ"},
+	"ZZ":      {amConstant, "mo", "ℤ"},
+	// functions
+	"sin":    {amConstant, "mi", "sin"},
+	"cos":    {amConstant, "mi", "cos"},
+	"tan":    {amConstant, "mi", "tan"},
+	"sec":    {amConstant, "mi", "sec"},
+	"csc":    {amConstant, "mi", "csc"},
+	"cot":    {amConstant, "mi", "cot"},
+	"arcsin": {amConstant, "mi", "arcsin"},
+	"arccos": {amConstant, "mi", "arccos"},
+	"arctan": {amConstant, "mi", "arctan"},
+	"sinh":   {amConstant, "mi", "sinh"},
+	"cosh":   {amConstant, "mi", "cosh"},
+	"tanh":   {amConstant, "mi", "tanh"},
+	"sech":   {amConstant, "mi", "sech"},
+	"csch":   {amConstant, "mi", "csch"},
+	"coth":   {amConstant, "mi", "coth"},
+	"exp":    {amConstant, "mi", "exp"},
+	"log":    {amConstant, "mi", "log"},
+	"ln":     {amConstant, "mi", "ln"},
+	"det":    {amConstant, "mi", "det"},
+	"dim":    {amConstant, "mi", "dim"},
+	"mod":    {amConstant, "mi", "mod"},
+	"gcd":    {amConstant, "mi", "gcd"},
+	"lcm":    {amConstant, "mi", "lcm"},
+	"lub":    {amConstant, "mi", "lub"},
+	"glb":    {amConstant, "mi", "glb"},
+	// arrows
+	"uarr":           {amConstant, "mo", "↑"},
+	"uparrow":        {amConstant, "mo", "↑"},
+	"darr":           {amConstant, "mo", "↓"},
+	"downarrow":      {amConstant, "mo", "↓"},
+	"rarr":           {amConstant, "mo", "→"},
+	"rightarrow":     {amConstant, "mo", "→"},
+	"->":             {amConstant, "mo", "→"},
+	"to":             {amConstant, "mo", "→"},
+	">->":            {amConstant, "mo", "↣"},
+	"->>":            {amConstant, "mo", "↠"},
+	">->>":           {amConstant, "mo", "⤖"},
+	"|->":            {amConstant, "mo", "↦"},
+	"mapsto":         {amConstant, "mo", "↦"},
+	"larr":           {amConstant, "mo", "←"},
+	"leftarrow":      {amConstant, "mo", "←"},
+	"harr":           {amConstant, "mo", "↔"},
+	"leftrightarrow": {amConstant, "mo", "↔"},
+	"rArr":           {amConstant, "mo", "⇒"},
+	"Rightarrow":     {amConstant, "mo", "⇒"},
+	"lArr":           {amConstant, "mo", "⇐"},
+	"Leftarrow":      {amConstant, "mo", "⇐"},
+	"hArr":           {amConstant, "mo", "⇔"},
+	"Leftrightarrow": {amConstant, "mo", "⇔"},
+	// brackets
+	"(":  {amLeftBracket, "mo", "("},
+	")":  {amRightBracket, "mo", ")"},
+	"[":  {amLeftBracket, "mo", "["},
+	"]":  {amRightBracket, "mo", "]"},
+	"{":  {amLeftBracket, "mo", "{"},
+	"}":  {amRightBracket, "mo", "}"},
+	"(:": {amLeftBracket, "mo", "⟨"},
+	":)": {amRightBracket, "mo", "⟩"},
+	"<<": {amLeftBracket, "mo", "⟨"},
+	">>": {amRightBracket, "mo", "⟩"},
+	"{:": {amLeftBracket, "mo", ""},
+	":}": {amRightBracket, "mo", ""},
+	// unary operators
+	"sqrt":       {amUnary, "", "sqrt"},
+	"text":       {amUnary, "", "text"},
+	"mbox":       {amUnary, "", "text"},
+	"hat":        {amUnary, "", "^"},
+	"bar":        {amUnary, "", "¯"},
+	"overline":   {amUnary, "", "¯"},
+	"vec":        {amUnary, "", "→"},
+	"dot":        {amUnary, "", "."},
+	"ddot":       {amUnary, "", ".."},
+	"tilde":      {amUnary, "", "~"},
+	"ul":         {amUnary, "", "̲"},
+	"underline":  {amUnary, "", "̲"},
+	"ubrace":     {amUnary, "", "⏟"},
+	"underbrace": {amUnary, "", "⏟"},
+	"obrace":     {amUnary, "", "⏞"},
+	"overbrace":  {amUnary, "", "⏞"},
+	"abs":        {amUnary, "", "abs"},
+	"floor":      {amUnary, "", "floor"},
+	"ceil":       {amUnary, "", "ceil"},
+	"norm":       {amUnary, "", "norm"},
+	"cancel":     {amUnary, "", "cancel"},
+	"bb":         {amUnary, "", "bold"},
+	"mathbf":     {amUnary, "", "bold"},
+	"bbb":        {amUnary, "", "double-struck"},
+	"mathbb":     {amUnary, "", "double-struck"},
+	"cc":         {amUnary, "", "script"},
+	"mathcal":    {amUnary, "", "script"},
+	"tt":         {amUnary, "", "monospace"},
+	"mathtt":     {amUnary, "", "monospace"},
+	"fr":         {amUnary, "", "fraktur"},
+	"mathfrak":   {amUnary, "", "fraktur"},
+	"sf":         {amUnary, "", "sans-serif"},
+	"mathsf":     {amUnary, "", "sans-serif"},
+	// binary operators
+	"frac":     {amBinary, "", "frac"},
+	"root":     {amBinary, "", "root"},
+	"stackrel": {amBinary, "", "overset"},
+	"overset":  {amBinary, "", "overset"},
+	"underset": {amBinary, "", "underset"},
+	"color":    {amBinary, "", "color"},
+	// infix operators
+	"/": {amFraction, "mo", "/"},
+	"_": {amSubscript, "mo", "_"},
+	"^": {amSuperscript, "mo", "^"},
+}
+
+// the length of the longest symbol, to limit the lookups in the table of symbols
+var asciiMathMaxSymbolLength = func() int {
+	l := 0
+	for s := range asciiMathSymbols {
+		if len(s) > l {
+			l = len(s)
+		}
+	}
+	return l
+}()
+
+type asciiMathToken struct {
+	input  string
+	kind   asciiMathKind
+	tag    string
+	output string
+}
+
+type asciiMathParser struct {
+	input string
+	pos   int
+}
+
+func (p *asciiMathParser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+// peek returns the next token, without consuming it (or nil if the end of the input was reached)
+func (p *asciiMathParser) peek() *asciiMathToken {
+	p.skipSpaces()
+	if p.pos >= len(p.input) {
+		return nil
+	}
+	rest := p.input[p.pos:]
+	// quoted text
+	if rest[0] == '"' {
+		if end := strings.IndexByte(rest[1:], '"'); end >= 0 {
+			return &asciiMathToken{
+				input:  rest[:end+2],
+				kind:   amText,
+				output: rest[1 : end+1],
+			}
+		}
+	}
+	// longest symbol
+	l := asciiMathMaxSymbolLength
+	if l > len(rest) {
+		l = len(rest)
+	}
+	for ; l > 0; l-- {
+		if s, found := asciiMathSymbols[rest[:l]]; found {
+			return &asciiMathToken{
+				input:  rest[:l],
+				kind:   s.kind,
+				tag:    s.tag,
+				output: s.output,
+			}
+		}
+	}
+	// number
+	if n := numberPrefix(rest); n != "" {
+		return &asciiMathToken{
+			input:  n,
+			kind:   amNumber,
+			output: n,
+		}
+	}
+	r, size := utf8.DecodeRuneInString(rest)
+	if unicode.IsLetter(r) {
+		return &asciiMathToken{
+			input:  rest[:size],
+			kind:   amIdentifier,
+			output: rest[:size],
+		}
+	}
+	return &asciiMathToken{
+		input:  rest[:size],
+		kind:   amOperator,
+		output: rest[:size],
+	}
+}
+
+func (p *asciiMathParser) consume(t *asciiMathToken) {
+	p.pos += len(t.input)
+}
+
+// rawGroup returns the raw content of the next group in parenthesis (eg: `(some text)`),
+// or false if the next character is not an opening parenthesis
+func (p *asciiMathParser) rawGroup() (string, bool) {
+	p.skipSpaces()
+	if p.pos >= len(p.input) || p.input[p.pos] != '(' {
+		return "", false
+	}
+	end := strings.IndexByte(p.input[p.pos:], ')')
+	if end < 0 {
+		return "", false
+	}
+	content := p.input[p.pos+1 : p.pos+end]
+	p.pos += end + 1
+	return content, true
+}
+
+// parseExpression parses a sequence of intermediate expressions, until the end of the input
+// or until a closing bracket if `nested` is true (in which case the closing bracket is also returned)
+func (p *asciiMathParser) parseExpression(nested bool) ([]node, *asciiMathToken) {
+	nodes := []node{}
+	for {
+		t := p.peek()
+		if t == nil {
+			return nodes, nil
+		}
+		if t.kind == amRightBracket {
+			p.consume(t)
+			if nested {
+				return nodes, t
+			}
+			if t.output != "" {
+				nodes = append(nodes, mo(t.output))
+			}
+			continue
+		}
+		n := p.parseIntermediate()
+		if t := p.peek(); t != nil && t.kind == amFraction {
+			p.consume(t)
+			n = newElement("mfrac", unwrap(n), unwrap(p.parseIntermediate()))
+		}
+		nodes = append(nodes, n)
+	}
+}
+
+// parseIntermediate parses a simple expression with its optional subscript and superscript
+func (p *asciiMathParser) parseIntermediate() node {
+	base, underover := p.parseSimple()
+	var sub, sup node
+	for {
+		t := p.peek()
+		switch {
+		case t != nil && t.kind == amSubscript && sub == nil:
+			p.consume(t)
+			s, _ := p.parseSimple()
+			sub = unwrap(s)
+		case t != nil && t.kind == amSuperscript && sup == nil:
+			p.consume(t)
+			s, _ := p.parseSimple()
+			sup = unwrap(s)
+		default:
+			return script(base, sub, sup, underover)
+		}
+	}
+}
+
+// parseSimple parses a symbol, a bracketed expression or an expression with an unary or binary operator.
+// Also returns `true` if the scripts of the expression must be placed under/over it.
+func (p *asciiMathParser) parseSimple() (node, bool) {
+	t := p.peek()
+	if t == nil {
+		return row{}, false
+	}
+	p.consume(t)
+	switch t.kind {
+	case amLeftBracket:
+		children, closing := p.parseExpression(true)
+		f := &fenced{
+			open:     t.output,
+			children: children,
+		}
+		if closing != nil {
+			f.close = closing.output
+		}
+		if m, ok := asciiMathMatrix(f); ok {
+			return m, false
+		}
+		return f, false
+	case amUnary:
+		return p.parseUnary(t), false
+	case amBinary:
+		return p.parseBinary(t), false
+	case amUnderOver:
+		return mo(t.output), true
+	case amConstant:
+		return &token{tag: t.tag, text: t.output}, false
+	case amText:
+		return mtext(t.output), false
+	case amNumber:
+		return mn(t.output), false
+	case amIdentifier:
+		return mi(t.output), false
+	default:
+		return mo(t.output), false
+	}
+}
+
+func (p *asciiMathParser) parseUnary(t *asciiMathToken) node {
+	if t.output == "text" {
+		if content, ok := p.rawGroup(); ok {
+			return mtext(content)
+		}
+	}
+	arg, _ := p.parseSimple()
+	arg = unwrap(arg)
+	switch t.output {
+	case "sqrt":
+		return newElement("msqrt", arg)
+	case "text":
+		return arg
+	case "abs":
+		return &fenced{open: "|", close: "|", children: []node{arg}}
+	case "floor":
+		return &fenced{open: "⌊", close: "⌋", children: []node{arg}}
+	case "ceil":
+		return &fenced{open: "⌈", close: "⌉", children: []node{arg}}
+	case "norm":
+		return &fenced{open: "∥", close: "∥", children: []node{arg}}
+	case "cancel":
+		e := newElement("menclose", arg)
+		e.attrs = []attribute{{"notation", "updiagonalstrike"}}
+		return e
+	case "̲", "⏟":
+		return accent(arg, t.output, true)
+	case "^", "¯", "→", ".", "..", "~", "⏞":
+		return accent(arg, t.output, false)
+	default: // font variants
+		return style(arg, t.output)
+	}
+}
+
+func (p *asciiMathParser) parseBinary(t *asciiMathToken) node {
+	if t.output == "color" {
+		if color, ok := p.rawGroup(); ok {
+			arg, _ := p.parseSimple()
+			e := newElement("mstyle", unwrap(arg))
+			e.attrs = []attribute{{"mathcolor", strings.TrimSpace(color)}}
+			return e
+		}
+	}
+	first, _ := p.parseSimple()
+	second, _ := p.parseSimple()
+	first, second = unwrap(first), unwrap(second)
+	switch t.output {
+	case "frac":
+		return newElement("mfrac", first, second)
+	case "root":
+		return newElement("mroot", second, first)
+	case "underset":
+		return newElement("munder", second, first)
+	default: // overset
+		return newElement("mover", second, first)
+	}
+}
+
+// asciiMathMatrix converts the given bracketed expression into a matrix if it contains
+// a comma-separated list of rows with the same kind of brackets and the same number of cells
+// (eg: `[(a,b),(c,d)]`)
+func asciiMathMatrix(f *fenced) (node, bool) {
+	rows := [][]node{}
+	var open string
+	for i, c := range f.children {
+		if i%2 == 1 {
+			if !isOperator(c, ",") {
+				return nil, false
+			}
+			continue
+		}
+		r, ok := c.(*fenced)
+		if !ok || (open != "" && r.open != open) || (r.open != "(" && r.open != "[") {
+			return nil, false
+		}
+		open = r.open
+		cells := splitOn(r.children, ",")
+		if len(rows) > 0 && len(cells) != len(rows[0]) {
+			return nil, false
+		}
+		rows = append(rows, cells)
+	}
+	if len(rows) < 2 || len(f.children)%2 == 0 {
+		return nil, false
+	}
+	return &fenced{
+		open:     f.open,
+		close:    f.close,
+		children: []node{table(rows)},
+	}, true
+}
+
+func isOperator(n node, op string) bool {
+	t, ok := n.(*token)
+	return ok && t.tag == "mo" && t.text == op
+}
+
+// splitOn splits the given nodes on the given operator
+func splitOn(nodes []node, op string) []node {
+	result := []node{}
+	current := row{}
+	for _, n := range nodes {
+		if isOperator(n, op) {
+			result = append(result, current)
+			current = row{}
+			continue
+		}
+		current = append(current, n)
+	}
+	return append(result, current)
+}
+
+// numberPrefix returns the number at the beginning of the given string (eg: `12` or `3.14`), if any
+func numberPrefix(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i == 0 {
+		return ""
+	}
+	if i+1 < len(s) && s[i] == '.' && s[i+1] >= '0' && s[i+1] <= '9' {
+		i++
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+	}
+	return s[:i]
+}
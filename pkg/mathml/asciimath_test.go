@@ -0,0 +1,43 @@
+package mathml_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/mathml"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("convert AsciiMath to MathML",
+
+	func(source, expected string) {
+		Expect(mathml.FromAsciiMath(source, false)).To(Equal(`<math xmlns="http://www.w3.org/1998/Math/MathML">` + expected + `</math>`))
+	},
+
+	Entry("number", "3.14", `<mn>3.14</mn>`),
+	Entry("identifiers and operators", "a+b=c", `<mrow><mi>a</mi><mo>+</mo><mi>b</mi><mo>=</mo><mi>c</mi></mrow>`),
+	Entry("symbols", "alpha <= oo", `<mrow><mi>α</mi><mo>≤</mo><mo>∞</mo></mrow>`),
+	Entry("square root", "sqrt(4) = 2", `<mrow><msqrt><mn>4</mn></msqrt><mo>=</mo><mn>2</mn></mrow>`),
+	Entry("fraction", "x/y", `<mfrac><mi>x</mi><mi>y</mi></mfrac>`),
+	Entry("fraction with brackets", "(a+1)/2", `<mfrac><mrow><mi>a</mi><mo>+</mo><mn>1</mn></mrow><mn>2</mn></mfrac>`),
+	Entry("subscript and superscript", "x_i^2", `<msubsup><mi>x</mi><mi>i</mi><mn>2</mn></msubsup>`),
+	Entry("sum with limits", "sum_(i=1)^n i",
+		`<mrow><munderover><mo>∑</mo><mrow><mi>i</mi><mo>=</mo><mn>1</mn></mrow><mi>n</mi></munderover><mi>i</mi></mrow>`),
+	Entry("limit", "lim_(x->oo) 1/x",
+		`<mrow><munder><mo>lim</mo><mrow><mi>x</mi><mo>→</mo><mo>∞</mo></mrow></munder><mfrac><mn>1</mn><mi>x</mi></mfrac></mrow>`),
+	Entry("function", "f(x)", `<mrow><mi>f</mi><mrow><mo>(</mo><mi>x</mi><mo>)</mo></mrow></mrow>`),
+	Entry("text", `text(hello world) + "quoted"`, `<mrow><mtext>hello world</mtext><mo>+</mo><mtext>quoted</mtext></mrow>`),
+	Entry("absolute value", "abs(x)", `<mrow><mo>|</mo><mi>x</mi><mo>|</mo></mrow>`),
+	Entry("accents", "hat(a) bar(b)",
+		`<mrow><mover accent="true"><mi>a</mi><mo>^</mo></mover><mover accent="true"><mi>b</mi><mo>¯</mo></mover></mrow>`),
+	Entry("color", "color(red)(x)", `<mstyle mathcolor="red"><mi>x</mi></mstyle>`),
+	Entry("matrix", "[[a,b],[c,d]]",
+		`<mrow><mo>[</mo><mtable><mtr><mtd><mi>a</mi></mtd><mtd><mi>b</mi></mtd></mtr><mtr><mtd><mi>c</mi></mtd><mtd><mi>d</mi></mtd></mtr></mtable><mo>]</mo></mrow>`),
+	Entry("escaped characters", "a < b", `<mrow><mi>a</mi><mo>&lt;</mo><mi>b</mi></mrow>`),
+)
+
+var _ = Describe("AsciiMath display", func() {
+
+	It("should convert as a block", func() {
+		Expect(mathml.FromAsciiMath("x", true)).To(Equal(`<math xmlns="http://www.w3.org/1998/Math/MathML" display="block"><mi>x</mi></math>`))
+	})
+})
@@ -0,0 +1,682 @@
+package mathml
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// FromLatexMath converts the given LaTeX math expression into a MathML `math` element,
+// displayed as a block (ie, on its own line) or inline
+func FromLatexMath(source string, block bool) string {
+	p := &latexMathParser{
+		input: source,
+	}
+	content := []node{}
+	for {
+		content = append(content, p.parseExpression()...)
+		t := p.peek()
+		if t == nil {
+			break
+		}
+		// skip unexpected closing braces, line breaks, etc. at the top-level
+		p.consume(t)
+	}
+	return math(content, block)
+}
+
+type latexMathKind int
+
+const (
+	lmCommand latexMathKind = iota
+	lmNumber
+	lmIdentifier
+	lmOperator
+	lmOpenBrace
+	lmCloseBrace
+	lmSubscript
+	lmSuperscript
+	lmAlignment
+)
+
+type latexMathToken struct {
+	input string
+	kind  latexMathKind
+	value string // command name without the leading backslash, or the character(s)
+}
+
+type latexMathSymbol struct {
+	tag       string
+	text      string
+	underover bool
+}
+
+var latexMathSymbols = map[string]latexMathSymbol{
+	// greek letters
+	"alpha":      {"mi", "α", false},
+	"beta":       {"mi", "β", false},
+	"gamma":      {"mi", "γ", false},
+	"delta":      {"mi", "δ", false},
+	"epsilon":    {"mi", "ϵ", false},
+	"varepsilon": {"mi", "ε", false},
+	"zeta":       {"mi", "ζ", false},
+	"eta":        {"mi", "η", false},
+	"theta":      {"mi", "θ", false},
+	"vartheta":   {"mi", "ϑ", false},
+	"iota":       {"mi", "ι", false},
+	"kappa":      {"mi", "κ", false},
+	"lambda":     {"mi", "λ", false},
+	"mu":         {"mi", "μ", false},
+	"nu":         {"mi", "ν", false},
+	"xi":         {"mi", "ξ", false},
+	"pi":         {"mi", "π", false},
+	"varpi":      {"mi", "ϖ", false},
+	"rho":        {"mi", "ρ", false},
+	"varrho":     {"mi", "ϱ", false},
+	"sigma":      {"mi", "σ", false},
+	"varsigma":   {"mi", "ς", false},
+	"tau":        {"mi", "τ", false},
+	"upsilon":    {"mi", "υ", false},
+	"phi":        {"mi", "ϕ", false},
+	"varphi":     {"mi", "φ", false},
+	"chi":        {"mi", "χ", false},
+	"psi":        {"mi", "ψ", false},
+	"omega":      {"mi", "ω", false},
+	"Gamma":      {"mi", "Γ", false},
+	"Delta":      {"mi", "Δ", false},
+	"Theta":      {"mi", "Θ", false},
+	"Lambda":     {"mi", "Λ", false},
+	"Xi":         {"mi", "Ξ", false},
+	"Pi":         {"mi", "Π", false},
+	"Sigma":      {"mi", "Σ", false},
+	"Upsilon":    {"mi", "Υ", false},
+	"Phi":        {"mi", "Φ", false},
+	"Psi":        {"mi", "Ψ", false},
+	"Omega":      {"mi", "Ω", false},
+	// binary operators
+	"times":    {"mo", "×", false},
+	"cdot":     {"mo", "⋅", false},
+	"div":      {"mo", "÷", false},
+	"pm":       {"mo", "±", false},
+	"mp":       {"mo", "∓", false},
+	"ast":      {"mo", "∗", false},
+	"star":     {"mo", "⋆", false},
+	"circ":     {"mo", "∘", false},
+	"bullet":   {"mo", "∙", false},
+	"oplus":    {"mo", "⊕", false},
+	"otimes":   {"mo", "⊗", false},
+	"odot":     {"mo", "⊙", false},
+	"cap":      {"mo", "∩", false},
+	"cup":      {"mo", "∪", false},
+	"wedge":    {"mo", "∧", false},
+	"land":     {"mo", "∧", false},
+	"vee":      {"mo", "∨", false},
+	"lor":      {"mo", "∨", false},
+	"neg":      {"mo", "¬", false},
+	"lnot":     {"mo", "¬", false},
+	"setminus": {"mo", "∖", false},
+	// relations
+	"leq":       {"mo", "≤", false},
+	"le":        {"mo", "≤", false},
+	"geq":       {"mo", "≥", false},
+	"ge":        {"mo", "≥", false},
+	"neq":       {"mo", "≠", false},
+	"ne":        {"mo", "≠", false},
+	"approx":    {"mo", "≈", false},
+	"equiv":     {"mo", "≡", false},
+	"sim":       {"mo", "∼", false},
+	"simeq":     {"mo", "≃", false},
+	"cong":      {"mo", "≅", false},
+	"propto":    {"mo", "∝", false},
+	"ll":        {"mo", "≪", false},
+	"gg":        {"mo", "≫", false},
+	"subset":    {"mo", "⊂", false},
+	"supset":    {"mo", "⊃", false},
+	"subseteq":  {"mo", "⊆", false},
+	"supseteq":  {"mo", "⊇", false},
+	"in":        {"mo", "∈", false},
+	"notin":     {"mo", "∉", false},
+	"ni":        {"mo", "∋", false},
+	"perp":      {"mo", "⊥", false},
+	"parallel":  {"mo", "∥", false},
+	"mid":       {"mo", "∣", false},
+	"models":    {"mo", "⊨", false},
+	"vdash":     {"mo", "⊢", false},
+	"prec":      {"mo", "≺", false},
+	"succ":      {"mo", "≻", false},
+	"preceq":    {"mo", "⪯", false},
+	"succeq":    {"mo", "⪰", false},
+	"colon":     {"mo", ":", false},
+	"coloneqq":  {"mo", "≔", false},
+	"triangleq": {"mo", "≜", false},
+	// arrows
+	"to":                {"mo", "→", false},
+	"rightarrow":        {"mo", "→", false},
+	"leftarrow":         {"mo", "←", false},
+	"gets":              {"mo", "←", false},
+	"leftrightarrow":    {"mo", "↔", false},
+	"Rightarrow":        {"mo", "⇒", false},
+	"Leftarrow":         {"mo", "⇐", false},
+	"Leftrightarrow":    {"mo", "⇔", false},
+	"iff":               {"mo", "⟺", false},
+	"implies":           {"mo", "⟹", false},
+	"mapsto":            {"mo", "↦", false},
+	"uparrow":           {"mo", "↑", false},
+	"downarrow":         {"mo", "↓", false},
+	"longrightarrow":    {"mo", "⟶", false},
+	"longleftarrow":     {"mo", "⟵", false},
+	"Longrightarrow":    {"mo", "⟹", false},
+	"Longleftarrow":     {"mo", "⟸", false},
+	"hookrightarrow":    {"mo", "↪", false},
+	"rightleftharpoons": {"mo", "⇌", false},
+	// miscellaneous symbols
+	"infty":      {"mi", "∞", false},
+	"partial":    {"mo", "∂", false},
+	"nabla":      {"mo", "∇", false},
+	"forall":     {"mo", "∀", false},
+	"exists":     {"mo", "∃", false},
+	"nexists":    {"mo", "∄", false},
+	"emptyset":   {"mi", "∅", false},
+	"varnothing": {"mi", "∅", false},
+	"aleph":      {"mi", "ℵ", false},
+	"hbar":       {"mi", "ℏ", false},
+	"ell":        {"mi", "ℓ", false},
+	"Re":         {"mi", "ℜ", false},
+	"Im":         {"mi", "ℑ", false},
+	"angle":      {"mo", "∠", false},
+	"triangle":   {"mo", "△", false},
+	"ldots":      {"mo", "…", false},
+	"dots":       {"mo", "…", false},
+	"cdots":      {"mo", "⋯", false},
+	"vdots":      {"mo", "⋮", false},
+	"ddots":      {"mo", "⋱", false},
+	"prime":      {"mo", "′", false},
+	"degree":     {"mo", "°", false},
+	"therefore":  {"mo", "∴", false},
+	"because":    {"mo", "∵", false},
+	"top":        {"mo", "⊤", false},
+	"bot":        {"mo", "⊥", false},
+	// large operators
+	"sum":       {"mo", "∑", true},
+	"prod":      {"mo", "∏", true},
+	"coprod":    {"mo", "∐", true},
+	"bigcup":    {"mo", "⋃", true},
+	"bigcap":    {"mo", "⋂", true},
+	"bigvee":    {"mo", "⋁", true},
+	"bigwedge":  {"mo", "⋀", true},
+	"bigoplus":  {"mo", "⨁", true},
+	"bigotimes": {"mo", "⨂", true},
+	"lim":       {"mo", "lim", true},
+	"limsup":    {"mo", "lim sup", true},
+	"liminf":    {"mo", "lim inf", true},
+	"max":       {"mo", "max", true},
+	"min":       {"mo", "min", true},
+	"sup":       {"mo", "sup", true},
+	"inf":       {"mo", "inf", true},
+	"int":       {"mo", "∫", false},
+	"iint":      {"mo", "∬", false},
+	"iiint":     {"mo", "∭", false},
+	"oint":      {"mo", "∮", false},
+	// functions
+	"sin":    {"mi", "sin", false},
+	"cos":    {"mi", "cos", false},
+	"tan":    {"mi", "tan", false},
+	"cot":    {"mi", "cot", false},
+	"sec":    {"mi", "sec", false},
+	"csc":    {"mi", "csc", false},
+	"arcsin": {"mi", "arcsin", false},
+	"arccos": {"mi", "arccos", false},
+	"arctan": {"mi", "arctan", false},
+	"sinh":   {"mi", "sinh", false},
+	"cosh":   {"mi", "cosh", false},
+	"tanh":   {"mi", "tanh", false},
+	"coth":   {"mi", "coth", false},
+	"exp":    {"mi", "exp", false},
+	"log":    {"mi", "log", false},
+	"ln":     {"mi", "ln", false},
+	"lg":     {"mi", "lg", false},
+	"deg":    {"mi", "deg", false},
+	"dim":    {"mi", "dim", false},
+	"ker":    {"mi", "ker", false},
+	"hom":    {"mi", "hom", false},
+	"arg":    {"mi", "arg", false},
+	"gcd":    {"mi", "gcd", false},
+	"det":    {"mi", "det", false},
+	"Pr":     {"mi", "Pr", false},
+	"bmod":   {"mo", "mod", false},
+	// delimiters
+	"{":      {"mo", "{", false},
+	"}":      {"mo", "}", false},
+	"lbrace": {"mo", "{", false},
+	"rbrace": {"mo", "}", false},
+	"langle": {"mo", "⟨", false},
+	"rangle": {"mo", "⟩", false},
+	"lvert":  {"mo", "|", false},
+	"rvert":  {"mo", "|", false},
+	"vert":   {"mo", "|", false},
+	"lVert":  {"mo", "‖", false},
+	"rVert":  {"mo", "‖", false},
+	"Vert":   {"mo", "‖", false},
+	"|":      {"mo", "‖", false},
+	"lfloor": {"mo", "⌊", false},
+	"rfloor": {"mo", "⌋", false},
+	"lceil":  {"mo", "⌈", false},
+	"rceil":  {"mo", "⌉", false},
+	// escaped characters
+	"%": {"mo", "%", false},
+	"$": {"mo", "$", false},
+	"&": {"mo", "&", false},
+	"#": {"mo", "#", false},
+	"_": {"mo", "_", false},
+}
+
+// the width of the spacing commands
+var latexMathSpaces = map[string]string{
+	",":          "0.1667em",
+	"thinspace":  "0.1667em",
+	":":          "0.2222em",
+	">":          "0.2222em",
+	"medspace":   "0.2222em",
+	";":          "0.2778em",
+	"thickspace": "0.2778em",
+	" ":          "0.3333em",
+	"quad":       "1em",
+	"qquad":      "2em",
+	"!":          "-0.1667em",
+}
+
+// the math variants of the font commands
+var latexMathVariants = map[string]string{
+	"mathbf":     "bold",
+	"boldsymbol": "bold-italic",
+	"bm":         "bold-italic",
+	"mathit":     "italic",
+	"mathbb":     "double-struck",
+	"mathcal":    "script",
+	"mathscr":    "script",
+	"mathfrak":   "fraktur",
+	"mathsf":     "sans-serif",
+	"mathtt":     "monospace",
+	"mathrm":     "normal",
+}
+
+// the characters of the accent commands (and whether they are placed under the base)
+var latexMathAccents = map[string]struct {
+	char  string
+	under bool
+}{
+	"hat":            {"^", false},
+	"widehat":        {"^", false},
+	"bar":            {"¯", false},
+	"overline":       {"¯", false},
+	"vec":            {"→", false},
+	"overrightarrow": {"→", false},
+	"dot":            {".", false},
+	"ddot":           {"..", false},
+	"tilde":          {"~", false},
+	"widetilde":      {"~", false},
+	"overbrace":      {"⏞", false},
+	"underline":      {"̲", true},
+	"underbrace":     {"⏟", true},
+}
+
+// the brackets of the matrix environments
+var latexMathEnvironments = map[string][2]string{
+	"matrix":   {"", ""},
+	"pmatrix":  {"(", ")"},
+	"bmatrix":  {"[", "]"},
+	"Bmatrix":  {"{", "}"},
+	"vmatrix":  {"|", "|"},
+	"Vmatrix":  {"‖", "‖"},
+	"cases":    {"{", ""},
+	"array":    {"", ""},
+	"aligned":  {"", ""},
+	"align":    {"", ""},
+	"align*":   {"", ""},
+	"gathered": {"", ""},
+	"split":    {"", ""},
+}
+
+type latexMathParser struct {
+	input string
+	pos   int
+}
+
+func (p *latexMathParser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+// peek returns the next token, without consuming it (or nil if the end of the input was reached)
+func (p *latexMathParser) peek() *latexMathToken {
+	p.skipSpaces()
+	if p.pos >= len(p.input) {
+		return nil
+	}
+	rest := p.input[p.pos:]
+	switch rest[0] {
+	case '\\':
+		i := 1
+		for i < len(rest) && isASCIILetter(rest[i]) {
+			i++
+		}
+		if i == 1 && len(rest) > 1 {
+			// single, non-letter character (eg: `\,` or `\{`)
+			_, size := utf8.DecodeRuneInString(rest[1:])
+			i += size
+		}
+		return &latexMathToken{input: rest[:i], kind: lmCommand, value: rest[1:i]}
+	case '{':
+		return &latexMathToken{input: "{", kind: lmOpenBrace, value: "{"}
+	case '}':
+		return &latexMathToken{input: "}", kind: lmCloseBrace, value: "}"}
+	case '_':
+		return &latexMathToken{input: "_", kind: lmSubscript, value: "_"}
+	case '^':
+		return &latexMathToken{input: "^", kind: lmSuperscript, value: "^"}
+	case '&':
+		return &latexMathToken{input: "&", kind: lmAlignment, value: "&"}
+	}
+	if n := numberPrefix(rest); n != "" {
+		return &latexMathToken{input: n, kind: lmNumber, value: n}
+	}
+	r, size := utf8.DecodeRuneInString(rest)
+	if unicode.IsLetter(r) {
+		return &latexMathToken{input: rest[:size], kind: lmIdentifier, value: rest[:size]}
+	}
+	return &latexMathToken{input: rest[:size], kind: lmOperator, value: rest[:size]}
+}
+
+func (p *latexMathParser) consume(t *latexMathToken) {
+	p.pos += len(t.input)
+}
+
+// isStop returns true if the given token ends the current expression
+func isStop(t *latexMathToken) bool {
+	switch {
+	case t.kind == lmCloseBrace || t.kind == lmAlignment:
+		return true
+	case t.kind == lmCommand && (t.value == "\\" || t.value == "right" || t.value == "end"):
+		return true
+	default:
+		return false
+	}
+}
+
+// parseExpression parses the elements until the end of the input, a closing brace, a `\right` or `\end` command,
+// or an alignment or a line break in an environment (the token which ends the expression is not consumed)
+func (p *latexMathParser) parseExpression() []node {
+	nodes := []node{}
+	for {
+		t := p.peek()
+		if t == nil || isStop(t) {
+			return nodes
+		}
+		if n := p.parseWithScripts(); n != nil {
+			nodes = append(nodes, n)
+		}
+	}
+}
+
+// parseExpressionUntil parses the elements until the given operator (eg: `]`), which is consumed
+func (p *latexMathParser) parseExpressionUntil(op string) []node {
+	nodes := []node{}
+	for {
+		t := p.peek()
+		if t == nil || isStop(t) {
+			return nodes
+		}
+		if t.kind == lmOperator && t.value == op {
+			p.consume(t)
+			return nodes
+		}
+		if n := p.parseWithScripts(); n != nil {
+			nodes = append(nodes, n)
+		}
+	}
+}
+
+// parseWithScripts parses an element with its optional subscript, superscript and primes
+func (p *latexMathParser) parseWithScripts() node {
+	base, underover := p.parseAtom()
+	var sub, sup node
+	primes := ""
+	for {
+		t := p.peek()
+		switch {
+		case t != nil && t.kind == lmSubscript && sub == nil:
+			p.consume(t)
+			sub = p.argument()
+		case t != nil && t.kind == lmSuperscript && sup == nil:
+			p.consume(t)
+			sup = p.argument()
+		case t != nil && t.kind == lmOperator && t.value == "'":
+			p.consume(t)
+			primes += "′"
+		case t != nil && t.kind == lmCommand && (t.value == "limits" || t.value == "nolimits"):
+			p.consume(t)
+			underover = t.value == "limits"
+		default:
+			if base == nil && (sub != nil || sup != nil || primes != "") {
+				base = row{}
+			}
+			if primes != "" {
+				if sup != nil {
+					sup = row{mo(primes), sup}
+				} else {
+					sup = mo(primes)
+				}
+			}
+			if base == nil {
+				return nil
+			}
+			return script(base, sub, sup, underover)
+		}
+	}
+}
+
+// parseAtom parses a single element: a group in braces, a number, a letter, an operator or a command (with its arguments).
+// Also returns `true` if the scripts of the element must be placed under/over it.
+func (p *latexMathParser) parseAtom() (node, bool) {
+	t := p.peek()
+	if t == nil || isStop(t) {
+		return row{}, false
+	}
+	p.consume(t)
+	switch t.kind {
+	case lmOpenBrace:
+		content := p.parseExpression()
+		p.consumeIf(lmCloseBrace)
+		return row(content), false
+	case lmNumber:
+		return mn(t.value), false
+	case lmIdentifier:
+		return mi(t.value), false
+	case lmOperator:
+		return mo(t.value), false
+	case lmCommand:
+		return p.parseCommand(t.value)
+	default: // subscript or superscript without base
+		return mo(t.value), false
+	}
+}
+
+func (p *latexMathParser) consumeIf(kind latexMathKind) bool {
+	if t := p.peek(); t != nil && t.kind == kind {
+		p.consume(t)
+		return true
+	}
+	return false
+}
+
+// rawArgument returns the raw content of the next argument in braces (eg: `{some text}`)
+func (p *latexMathParser) rawArgument() string {
+	p.skipSpaces()
+	if p.pos >= len(p.input) || p.input[p.pos] != '{' {
+		return ""
+	}
+	depth := 0
+	for i := p.pos; i < len(p.input); i++ {
+		switch p.input[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				content := p.input[p.pos+1 : i]
+				p.pos = i + 1
+				return content
+			}
+		}
+	}
+	content := p.input[p.pos+1:]
+	p.pos = len(p.input)
+	return content
+}
+
+// argument returns the next element, or an empty row if there is none
+// (eg: when the argument is a command such as `\displaystyle` which produces no element)
+func (p *latexMathParser) argument() node {
+	if n, _ := p.parseAtom(); n != nil {
+		return n
+	}
+	return row{}
+}
+
+// delimiter returns the delimiter after a `\left` or `\right` command (or an empty string for `.`)
+func (p *latexMathParser) delimiter() string {
+	t := p.peek()
+	if t == nil {
+		return ""
+	}
+	p.consume(t)
+	switch {
+	case t.kind == lmOperator && t.value == ".":
+		return ""
+	case t.kind == lmCommand:
+		if s, found := latexMathSymbols[t.value]; found {
+			return s.text
+		}
+		return ""
+	default:
+		return t.value
+	}
+}
+
+func (p *latexMathParser) parseCommand(name string) (node, bool) {
+	if s, found := latexMathSymbols[name]; found {
+		if s.tag == "mi" && utf8.RuneCountInString(s.text) == 1 && unicode.IsUpper([]rune(s.text)[0]) {
+			// uppercase greek letters are upright
+			return mi(s.text, attribute{"mathvariant", "normal"}), s.underover
+		}
+		return &token{tag: s.tag, text: s.text}, s.underover
+	}
+	if width, found := latexMathSpaces[name]; found {
+		e := newElement("mspace")
+		e.attrs = []attribute{{"width", width}}
+		return e, false
+	}
+	if variant, found := latexMathVariants[name]; found {
+		return style(p.argument(), variant), false
+	}
+	if a, found := latexMathAccents[name]; found {
+		return accent(p.argument(), a.char, a.under), false
+	}
+	switch name {
+	case "frac", "dfrac", "tfrac", "cfrac":
+		num := p.argument()
+		return newElement("mfrac", num, p.argument()), false
+	case "binom", "dbinom", "tbinom":
+		top := p.argument()
+		f := newElement("mfrac", top, p.argument())
+		f.attrs = []attribute{{"linethickness", "0"}}
+		return &fenced{open: "(", close: ")", children: []node{f}}, false
+	case "sqrt":
+		if t := p.peek(); t != nil && t.kind == lmOperator && t.value == "[" {
+			p.consume(t)
+			index := row(p.parseExpressionUntil("]"))
+			return newElement("mroot", p.argument(), index), false
+		}
+		return newElement("msqrt", p.argument()), false
+	case "left":
+		open := p.delimiter()
+		content := p.parseExpression()
+		close := ""
+		if t := p.peek(); t != nil && t.kind == lmCommand && t.value == "right" {
+			p.consume(t)
+			close = p.delimiter()
+		}
+		return &fenced{open: open, close: close, children: content}, false
+	case "text", "textrm", "textit", "textbf", "mbox":
+		return mtext(p.rawArgument()), false
+	case "operatorname":
+		return mi(p.rawArgument()), false
+	case "overset", "stackrel":
+		over := p.argument()
+		return newElement("mover", p.argument(), over), false
+	case "underset":
+		under := p.argument()
+		return newElement("munder", p.argument(), under), false
+	case "begin":
+		return p.parseEnvironment(p.rawArgument()), false
+	case "displaystyle", "textstyle", "scriptstyle", "limits", "nolimits":
+		return nil, false
+	default:
+		e := newElement("merror", mtext("\\"+name))
+		return e, false
+	}
+}
+
+// parseEnvironment parses the rows and cells of the given environment (eg: `pmatrix`), until the `\end` command
+func (p *latexMathParser) parseEnvironment(name string) node {
+	if name == "array" {
+		p.rawArgument() // skip the columns specification
+	}
+	rows := [][]node{}
+	cells := []node{}
+	for {
+		cells = append(cells, row(p.parseExpression()))
+		t := p.peek()
+		if t == nil {
+			break
+		}
+		p.consume(t)
+		if t.kind == lmAlignment {
+			continue
+		}
+		rows = append(rows, cells)
+		cells = []node{}
+		if t.kind == lmCommand && t.value == "end" {
+			p.rawArgument()
+			break
+		}
+		if t.kind != lmCommand || t.value != "\\" {
+			// unexpected closing brace or `\right`
+			break
+		}
+	}
+	if len(cells) > 1 || (len(cells) == 1 && len(cells[0].(row)) > 0) {
+		rows = append(rows, cells)
+	}
+	// ignore the trailing empty row (eg: when the last row ends with `\\`)
+	if l := len(rows); l > 1 && len(rows[l-1]) == 1 && len(rows[l-1][0].(row)) == 0 {
+		rows = rows[:l-1]
+	}
+	brackets, found := latexMathEnvironments[name]
+	if !found {
+		return newElement("merror", mtext("\\begin{"+name+"}"))
+	}
+	t := table(rows)
+	if strings.HasPrefix(name, "align") || name == "cases" || name == "split" || name == "aligned" {
+		t.(*element).attrs = []attribute{{"columnalign", "left"}}
+	}
+	if brackets[0] == "" && brackets[1] == "" {
+		return t
+	}
+	return &fenced{open: brackets[0], close: brackets[1], children: []node{t}}
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,100 @@
+package mathml_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/mathml"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("convert LaTeX math to MathML",
+
+	func(source, expected string) {
+		Expect(mathml.FromLatexMath(source, false)).To(Equal(`<math xmlns="http://www.w3.org/1998/Math/MathML">` + expected + `</math>`))
+	},
+
+	// identifiers, numbers and operators
+	Entry("number", `3.14`, `<mn>3.14</mn>`),
+	Entry("escaped operator", `a < b`, `<mrow><mi>a</mi><mo>&lt;</mo><mi>b</mi></mrow>`),
+	Entry("escaped characters", `\{ \% \}`, `<mrow><mo>{</mo><mo>%</mo><mo>}</mo></mrow>`),
+	// symbols
+	Entry("greek letters", `\beta \Delta`, `<mrow><mi>β</mi><mi mathvariant="normal">Δ</mi></mrow>`),
+	Entry("binary operators", `a \times b \pm c`, `<mrow><mi>a</mi><mo>×</mo><mi>b</mi><mo>±</mo><mi>c</mi></mrow>`),
+	Entry("relations", `a \leq b \neq c`, `<mrow><mi>a</mi><mo>≤</mo><mi>b</mi><mo>≠</mo><mi>c</mi></mrow>`),
+	Entry("arrows and miscellaneous symbols", `x \to \infty`, `<mrow><mi>x</mi><mo>→</mo><mi>∞</mi></mrow>`),
+	Entry("functions", `\sin x + \log y`, `<mrow><mi>sin</mi><mi>x</mi><mo>+</mo><mi>log</mi><mi>y</mi></mrow>`),
+	// scripts and large operators
+	Entry("subscript and superscript", `x^2 + y_1^{n+1}`,
+		`<mrow><msup><mi>x</mi><mn>2</mn></msup><mo>+</mo><msubsup><mi>y</mi><mn>1</mn><mrow><mi>n</mi><mo>+</mo><mn>1</mn></mrow></msubsup></mrow>`),
+	Entry("primes", `f''`, `<msup><mi>f</mi><mo>′′</mo></msup>`),
+	Entry("prime and superscript", `x'^2`, `<msup><mi>x</mi><mrow><mo>′</mo><mn>2</mn></mrow></msup>`),
+	Entry("superscript without base", `^2`, `<mrow><mo>^</mo><mn>2</mn></mrow>`),
+	Entry("sum with limits", `\sum_{i=1}^{n} i`,
+		`<mrow><munderover><mo>∑</mo><mrow><mi>i</mi><mo>=</mo><mn>1</mn></mrow><mi>n</mi></munderover><mi>i</mi></mrow>`),
+	Entry("limit", `\lim_{x \to 0} x`,
+		`<mrow><munder><mo>lim</mo><mrow><mi>x</mi><mo>→</mo><mn>0</mn></mrow></munder><mi>x</mi></mrow>`),
+	Entry("integral", `\int_0^1 x\,dx`,
+		`<mrow><msubsup><mo>∫</mo><mn>0</mn><mn>1</mn></msubsup><mi>x</mi><mspace width="0.1667em"></mspace><mi>d</mi><mi>x</mi></mrow>`),
+	Entry("integral with explicit limits", `\int\limits_0^1 x`,
+		`<mrow><munderover><mo>∫</mo><mn>0</mn><mn>1</mn></munderover><mi>x</mi></mrow>`),
+	Entry("sum without limits", `\sum\nolimits_i x`, `<mrow><msub><mo>∑</mo><mi>i</mi></msub><mi>x</mi></mrow>`),
+	// spaces
+	Entry("spaces", `a\,b\;c\quad d\!e`,
+		`<mrow><mi>a</mi><mspace width="0.1667em"></mspace><mi>b</mi><mspace width="0.2778em"></mspace><mi>c</mi><mspace width="1em"></mspace><mi>d</mi><mspace width="-0.1667em"></mspace><mi>e</mi></mrow>`),
+	// fractions and roots
+	Entry("fraction", `\frac{a}{b}`, `<mfrac><mi>a</mi><mi>b</mi></mfrac>`),
+	Entry("display fraction", `\dfrac{1}{x}`, `<mfrac><mn>1</mn><mi>x</mi></mfrac>`),
+	Entry("binomial", `\binom{n}{k}`, `<mrow><mo>(</mo><mfrac linethickness="0"><mi>n</mi><mi>k</mi></mfrac><mo>)</mo></mrow>`),
+	Entry("square root", `\sqrt{2}`, `<msqrt><mn>2</mn></msqrt>`),
+	Entry("root", `\sqrt[3]{x}`, `<mroot><mi>x</mi><mn>3</mn></mroot>`),
+	// delimiters
+	Entry("left and right delimiters", `\left( \frac{1}{2} \right)`,
+		`<mrow><mo>(</mo><mfrac><mn>1</mn><mn>2</mn></mfrac><mo>)</mo></mrow>`),
+	Entry("left and right delimiter commands", `\left\langle x \right\rangle`, `<mrow><mo>⟨</mo><mi>x</mi><mo>⟩</mo></mrow>`),
+	Entry("invisible right delimiter", `\left[ x \right.`, `<mrow><mo>[</mo><mi>x</mi></mrow>`),
+	// fonts and accents
+	Entry("font variants and greek letters", `\mathbb{R} \alpha \Gamma`,
+		`<mrow><mstyle mathvariant="double-struck"><mi>R</mi></mstyle><mi>α</mi><mi mathvariant="normal">Γ</mi></mrow>`),
+	Entry("font variants", `\mathbf{v} \mathcal{F}`,
+		`<mrow><mstyle mathvariant="bold"><mi>v</mi></mstyle><mstyle mathvariant="script"><mi>F</mi></mstyle></mrow>`),
+	Entry("accent and prime", `\hat{x} f'(x)`,
+		`<mrow><mover accent="true"><mi>x</mi><mo>^</mo></mover><msup><mi>f</mi><mo>′</mo></msup><mo>(</mo><mi>x</mi><mo>)</mo></mrow>`),
+	Entry("accents", `\vec{v} \bar{x}`,
+		`<mrow><mover accent="true"><mi>v</mi><mo>→</mo></mover><mover accent="true"><mi>x</mi><mo>¯</mo></mover></mrow>`),
+	Entry("accent under", `\underline{x}`, `<munder accentunder="true"><mi>x</mi><mo>̲</mo></munder>`),
+	Entry("brace over", `\overbrace{x}`, `<mover accent="true"><mi>x</mi><mo>⏞</mo></mover>`),
+	// text and stacked elements
+	Entry("text", `\text{if } x \geq 0`, `<mrow><mtext>if </mtext><mi>x</mi><mo>≥</mo><mn>0</mn></mrow>`),
+	Entry("operator name", `\operatorname{sgn} x`, `<mrow><mi>sgn</mi><mi>x</mi></mrow>`),
+	Entry("overset", `\overset{!}{=}`, `<mover><mo>=</mo><mo>!</mo></mover>`),
+	Entry("stackrel", `\stackrel{def}{=}`, `<mover><mo>=</mo><mrow><mi>d</mi><mi>e</mi><mi>f</mi></mrow></mover>`),
+	Entry("underset", `\underset{x}{\arg}`, `<munder><mi>arg</mi><mi>x</mi></munder>`),
+	// environments
+	Entry("matrix", `\begin{pmatrix} a & b \\ c & d \end{pmatrix}`,
+		`<mrow><mo>(</mo><mtable><mtr><mtd><mi>a</mi></mtd><mtd><mi>b</mi></mtd></mtr><mtr><mtd><mi>c</mi></mtd><mtd><mi>d</mi></mtd></mtr></mtable><mo>)</mo></mrow>`),
+	Entry("matrix with brackets", `\begin{bmatrix} 1 \\ 2 \end{bmatrix}`,
+		`<mrow><mo>[</mo><mtable><mtr><mtd><mn>1</mn></mtd></mtr><mtr><mtd><mn>2</mn></mtd></mtr></mtable><mo>]</mo></mrow>`),
+	Entry("cases", `\begin{cases} 1 & x \\ 0 & y \end{cases}`,
+		`<mrow><mo>{</mo><mtable columnalign="left"><mtr><mtd><mn>1</mn></mtd><mtd><mi>x</mi></mtd></mtr><mtr><mtd><mn>0</mn></mtd><mtd><mi>y</mi></mtd></mtr></mtable></mrow>`),
+	Entry("array", `\begin{array}{cc} a & b \end{array}`, `<mtable><mtr><mtd><mi>a</mi></mtd><mtd><mi>b</mi></mtd></mtr></mtable>`),
+	Entry("unknown environment", `\begin{foo} a \end{foo}`, `<merror><mtext>\begin{foo}</mtext></merror>`),
+	// errors
+	Entry("unknown command", `\foo`, `<merror><mtext>\foo</mtext></merror>`),
+	Entry("unexpected closing brace", `}x`, `<mi>x</mi>`),
+	// commands without element
+	Entry("style command", `\displaystyle x`, `<mi>x</mi>`),
+	Entry("square root of limits", `\sqrt\limits`, `<msqrt><mrow></mrow></msqrt>`),
+	Entry("fraction of styles", `\frac\displaystyle\textstyle`, `<mfrac><mrow></mrow><mrow></mrow></mfrac>`),
+	Entry("accent on style", `\hat\scriptstyle`, `<mover accent="true"><mrow></mrow><mo>^</mo></mover>`),
+	Entry("font variant on limits", `\mathbb\nolimits`, `<mstyle mathvariant="double-struck"><mrow></mrow></mstyle>`),
+	Entry("overset with limits", `\overset\limits x`, `<mover><mi>x</mi><mrow></mrow></mover>`),
+	Entry("underset with limits", `\underset{a}\limits`, `<munder><mrow></mrow><mi>a</mi></munder>`),
+	Entry("superscript with style", `x^\displaystyle`, `<msup><mi>x</mi><mrow></mrow></msup>`),
+)
+
+var _ = Describe("LaTeX math display", func() {
+
+	It("should convert as a block", func() {
+		Expect(mathml.FromLatexMath("x", true)).To(Equal(`<math xmlns="http://www.w3.org/1998/Math/MathML" display="block"><mi>x</mi></math>`))
+	})
+})
@@ -0,0 +1,205 @@
+// Package mathml converts AsciiMath and LaTeX math expressions into MathML,
+// so that equations can be displayed by the browsers without any JavaScript library.
+package mathml
+
+import (
+	"strings"
+)
+
+const namespace = "http://www.w3.org/1998/Math/MathML"
+
+// node a node of the MathML tree
+type node interface {
+	write(b *strings.Builder)
+}
+
+// token a MathML token element, ie, `mi`, `mn`, `mo` or `mtext`
+type token struct {
+	tag   string
+	text  string
+	attrs []attribute
+}
+
+func (t *token) write(b *strings.Builder) {
+	b.WriteString("<" + t.tag)
+	writeAttributes(b, t.attrs)
+	b.WriteString(">")
+	b.WriteString(escape(t.text))
+	b.WriteString("</" + t.tag + ">")
+}
+
+// element a MathML element with children (eg: `mfrac`, `msqrt`, `msub`, etc.)
+type element struct {
+	tag      string
+	attrs    []attribute
+	children []node
+}
+
+func (e *element) write(b *strings.Builder) {
+	b.WriteString("<" + e.tag)
+	writeAttributes(b, e.attrs)
+	b.WriteString(">")
+	for _, c := range e.children {
+		c.write(b)
+	}
+	b.WriteString("</" + e.tag + ">")
+}
+
+// row a sequence of nodes, written in a `mrow` element unless it contains a single node
+type row []node
+
+func (r row) write(b *strings.Builder) {
+	if len(r) == 1 {
+		r[0].write(b)
+		return
+	}
+	b.WriteString("<mrow>")
+	for _, n := range r {
+		n.write(b)
+	}
+	b.WriteString("</mrow>")
+}
+
+// fenced a sequence of nodes surrounded by brackets (which may be invisible)
+type fenced struct {
+	open     string
+	close    string
+	children []node
+}
+
+func (f *fenced) write(b *strings.Builder) {
+	// always wrap in a `mrow`, so the content is handled as a single node in a script or fraction
+	b.WriteString("<mrow>")
+	if f.open != "" {
+		fence(f.open).write(b)
+	}
+	for _, c := range f.children {
+		c.write(b)
+	}
+	if f.close != "" {
+		fence(f.close).write(b)
+	}
+	b.WriteString("</mrow>")
+}
+
+type attribute struct {
+	name  string
+	value string
+}
+
+func writeAttributes(b *strings.Builder, attrs []attribute) {
+	for _, a := range attrs {
+		b.WriteString(" " + a.name + `="` + escape(a.value) + `"`)
+	}
+}
+
+func escape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;").Replace(s)
+}
+
+func mi(text string, attrs ...attribute) *token {
+	return &token{tag: "mi", text: text, attrs: attrs}
+}
+
+func mn(text string) *token {
+	return &token{tag: "mn", text: text}
+}
+
+func mo(text string, attrs ...attribute) *token {
+	return &token{tag: "mo", text: text, attrs: attrs}
+}
+
+func mtext(text string) *token {
+	return &token{tag: "mtext", text: text}
+}
+
+func fence(text string) *token {
+	return mo(text) // fences are stretchy by default (see the MathML operator dictionary)
+}
+
+func newElement(tag string, children ...node) *element {
+	e := &element{tag: tag}
+	for _, c := range children {
+		// a missing child is replaced with an empty row, so the element keeps the expected number of children
+		if c == nil {
+			c = row{}
+		}
+		e.children = append(e.children, c)
+	}
+	return e
+}
+
+// script returns the given base with the optional subscript and/or superscript.
+// Scripts are placed under and over the base for operators such as `sum` or `lim`
+func script(base, sub, sup node, underover bool) node {
+	switch {
+	case sub != nil && sup != nil && underover:
+		return newElement("munderover", base, sub, sup)
+	case sub != nil && sup != nil:
+		return newElement("msubsup", base, sub, sup)
+	case sub != nil && underover:
+		return newElement("munder", base, sub)
+	case sub != nil:
+		return newElement("msub", base, sub)
+	case sup != nil && underover:
+		return newElement("mover", base, sup)
+	case sup != nil:
+		return newElement("msup", base, sup)
+	default:
+		return base
+	}
+}
+
+// accent returns the given base with the given character over it (or under it)
+func accent(base node, char string, under bool) node {
+	if under {
+		e := newElement("munder", base, mo(char))
+		e.attrs = []attribute{{"accentunder", "true"}}
+		return e
+	}
+	e := newElement("mover", base, mo(char))
+	e.attrs = []attribute{{"accent", "true"}}
+	return e
+}
+
+// style returns the given node with the given math variant (eg: `bold`)
+func style(n node, variant string) node {
+	e := newElement("mstyle", n)
+	e.attrs = []attribute{{"mathvariant", variant}}
+	return e
+}
+
+// table returns a `mtable` with the given rows of cells
+func table(rows [][]node) node {
+	t := newElement("mtable")
+	for _, r := range rows {
+		tr := newElement("mtr")
+		for _, c := range r {
+			tr.children = append(tr.children, newElement("mtd", c))
+		}
+		t.children = append(t.children, tr)
+	}
+	return t
+}
+
+// math returns the `math` element with the given content
+func math(content []node, block bool) string {
+	b := &strings.Builder{}
+	b.WriteString(`<math xmlns="` + namespace + `"`)
+	if block {
+		b.WriteString(` display="block"`)
+	}
+	b.WriteString(">")
+	row(content).write(b)
+	b.WriteString("</math>")
+	return b.String()
+}
+
+// unwrap returns the content of the given node if it is surrounded by brackets
+// (eg: the `(a+b)` argument in `sqrt(a+b)` or `(a)/(b)`)
+func unwrap(n node) node {
+	if f, ok := n.(*fenced); ok {
+		return row(f.children)
+	}
+	return n
+}
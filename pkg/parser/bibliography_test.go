@@ -0,0 +1,145 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("bibliographies", func() {
+
+	Context("in final documents", func() {
+
+		It("with anchors in bibliography list", func() {
+			source := `[bibliography]
+* [[[pp]]] Andy Hunt and Dave Thomas. The Pragmatic Programmer.
+* [[[gof,Gang of Four]]] Erich Gamma et al. Design Patterns.`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.List{
+						Kind: types.UnorderedListKind,
+						Attributes: types.Attributes{
+							types.AttrStyle: "bibliography",
+						},
+						Elements: []types.ListElement{
+							&types.UnorderedListElement{
+								BulletStyle: types.OneAsterisk,
+								CheckStyle:  types.NoCheck,
+								Elements: []interface{}{
+									&types.Paragraph{
+										Elements: []interface{}{
+											&types.BibliographyAnchor{
+												ID:    "pp",
+												Label: "pp",
+											},
+											&types.StringElement{
+												Content: " Andy Hunt and Dave Thomas. The Pragmatic Programmer.",
+											},
+										},
+									},
+								},
+							},
+							&types.UnorderedListElement{
+								BulletStyle: types.OneAsterisk,
+								CheckStyle:  types.NoCheck,
+								Elements: []interface{}{
+									&types.Paragraph{
+										Elements: []interface{}{
+											&types.BibliographyAnchor{
+												ID:    "gof",
+												Label: "Gang of Four",
+											},
+											&types.StringElement{
+												Content: " Erich Gamma et al. Design Patterns.",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ElementReferences: types.ElementReferences{
+					"pp":  "[pp]",
+					"gof": "[Gang of Four]",
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with escaped anchor", func() {
+			source := `\[[[pp]]] is not an anchor`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Paragraph{
+						Elements: []interface{}{
+							&types.StringElement{
+								Content: "[[[pp]]] is not an anchor",
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with cite and citenp macros", func() {
+			source := `See cite:[lane2012, hunt2000(p. 42)] and citenp:[brown2008].`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Paragraph{
+						Elements: []interface{}{
+							&types.StringElement{
+								Content: "See ",
+							},
+							&types.InlineCitation{
+								Kind: types.Citation,
+								References: []*types.CitationReference{
+									{
+										Key: "lane2012",
+									},
+									{
+										Key:     "hunt2000",
+										Locator: "p. 42",
+									},
+								},
+							},
+							&types.StringElement{
+								Content: " and ",
+							},
+							&types.InlineCitation{
+								Kind: types.NonParentheticalCitation,
+								References: []*types.CitationReference{
+									{
+										Key: "brown2008",
+									},
+								},
+							},
+							&types.StringElement{
+								Content: ".",
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with bibliography macro", func() {
+			source := `.References
+bibliography::[]`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.BibliographyBlock{
+						Attributes: types.Attributes{
+							types.AttrTitle: "References",
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+	})
+})
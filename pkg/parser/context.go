@@ -11,43 +11,68 @@ import (
 
 type ParseContext struct {
 	filename     string
+	baseDir      string // the directory of the document if it was not read from a file
+	safeMode     configuration.SafeMode
+	jailDir      string // the directory outside of which the files cannot be included in the `safe` and `server` modes
 	opts         []Option
 	levelOffsets levelOffsets
 	attributes   *contextAttributes
 	userMacros   map[string]configuration.MacroTemplate
+	extensions   configuration.Extensions
+	diagrams     map[string]bool // the kinds of diagrams which are recognized
 	counters     map[string]interface{}
+	diagnostics  *types.Diagnostics
+	position     *types.SourcePosition // position of the block element being processed, if known
 }
 
 func NewParseContext(config *configuration.Configuration, options ...Option) *ParseContext {
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debugf("new parser context with attributes: %s", spew.Sdump(config.Attributes))
 	}
+	diagrams := diagramKinds(config)
 	opts := []Option{
 		Entrypoint("DocumentFragment"),
 		GlobalStore(frontMatterKey, true),
 		GlobalStore(documentHeaderKey, true),
 		GlobalStore(usermacrosKey, config.Macros),
+		GlobalStore(macroProcessorsKey, config.Extensions.MacroProcessors),
+		GlobalStore(diagramsKey, diagrams),
 		GlobalStore(enabledSubstitutionsKey, normalSubstitutions()),
+		GlobalStore(diagnosticsKey, config.Diagnostics),
+		GlobalStore(filenameKey, sourceFile(config.Filename)),
 	}
 	opts = append(opts, options...)
 	return &ParseContext{
 		filename:     config.Filename,
+		baseDir:      config.BaseDir,
+		safeMode:     config.SafeMode,
+		jailDir:      config.JailDir(),
 		opts:         opts,
 		levelOffsets: []*levelOffset{},
-		attributes:   newContextAttributes(config.Attributes),
+		attributes:   newContextAttributes(config),
 		userMacros:   config.Macros,
+		extensions:   config.Extensions,
+		diagrams:     diagrams,
 		counters:     map[string]interface{}{},
+		diagnostics:  config.Diagnostics,
 	}
 }
 
 func (c *ParseContext) Clone() *ParseContext {
 	return &ParseContext{
 		filename:     c.filename,
+		baseDir:      c.baseDir,
+		safeMode:     c.safeMode,
+		jailDir:      c.jailDir,
 		opts:         options(c.opts).clone(),
 		levelOffsets: c.levelOffsets.clone(),
 		attributes:   c.attributes.clone(),
 		userMacros:   c.userMacros,
+		extensions:   c.extensions,
+		diagrams:     c.diagrams,
 		counters:     c.counters,
+		diagnostics:  c.diagnostics,
+		position:     c.position,
 	}
 }
 
@@ -59,16 +84,20 @@ func (o options) clone() []Option {
 	return result
 }
 
+// contextAttributes the attributes of the document being processed, along with the attributes set (or unset) with the CLI or the API,
+// which are locked (ie, which cannot be changed in the document) unless they were soft-set
 type contextAttributes struct {
-	immutableAttributes types.Attributes
-	attributes          types.Attributes
+	immutableAttributes types.Attributes // the attributes set with the CLI or the API
+	unsetAttributes     map[string]bool  // the attributes unset with the CLI or the API
+	attributes          types.Attributes // the attributes declared in the document, initialized with the soft-set attributes
 	mutex               *sync.RWMutex
 }
 
-func newContextAttributes(attrs types.Attributes) *contextAttributes {
+func newContextAttributes(config *configuration.Configuration) *contextAttributes {
 	return &contextAttributes{
-		immutableAttributes: attrs,
-		attributes:          types.Attributes{},
+		immutableAttributes: config.Attributes,
+		unsetAttributes:     config.UnsetAttributes,
+		attributes:          config.SoftAttributes.Clone(),
 		mutex:               &sync.RWMutex{},
 	}
 }
@@ -79,6 +108,7 @@ func (a *contextAttributes) clone() *contextAttributes {
 	return &contextAttributes{
 		mutex:               &sync.RWMutex{},
 		immutableAttributes: a.immutableAttributes.Clone(),
+		unsetAttributes:     a.unsetAttributes,
 		attributes:          a.attributes.Clone(),
 	}
 }
@@ -86,7 +116,9 @@ func (a *contextAttributes) clone() *contextAttributes {
 func (a *contextAttributes) allAttributes() map[string]interface{} {
 	result := make(map[string]interface{}, len(a.attributes)+len(a.immutableAttributes))
 	for k, v := range a.attributes {
-		result[k] = v
+		if !a.unsetAttributes[k] {
+			result[k] = v
+		}
 	}
 	// imautables attributes should not be overridden, hence adding them after
 	for k, v := range a.immutableAttributes {
@@ -99,6 +131,9 @@ func (a *contextAttributes) get(k string) (interface{}, bool) {
 	if v, found := a.immutableAttributes[k]; found {
 		return v, true
 	}
+	if a.unsetAttributes[k] {
+		return nil, false
+	}
 	v, found := a.attributes[k]
 	return v, found
 }
@@ -107,6 +142,9 @@ func (a *contextAttributes) getAsIntWithDefault(k string, defaultValue int) int
 	if a.immutableAttributes.Has(k) {
 		return a.immutableAttributes.GetAsIntWithDefault(k, defaultValue)
 	}
+	if a.unsetAttributes[k] {
+		return defaultValue
+	}
 	return a.attributes.GetAsIntWithDefault(k, defaultValue)
 }
 
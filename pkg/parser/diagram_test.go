@@ -0,0 +1,138 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("diagrams", func() {
+
+	Context("in final documents", func() {
+
+		It("from listing block", func() {
+			source := `[graphviz,states,png]
+----
+digraph { a -> b & c }
+----`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.DiagramBlock{
+						Kind:   "graphviz",
+						Source: "digraph { a -> b & c }",
+						Attributes: types.Attributes{
+							types.AttrDiagramTarget: "states",
+							types.AttrImageFormat:   "png",
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("from literal block with title", func() {
+			source := `.Sequence
+[plantuml,format=svg]
+....
+Alice -> Bob: "hello"
+....`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.DiagramBlock{
+						Kind:   "plantuml",
+						Source: `Alice -> Bob: "hello"`,
+						Attributes: types.Attributes{
+							types.AttrTitle:       "Sequence",
+							types.AttrImageFormat: "svg",
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("from paragraph", func() {
+			source := `[dot]
+digraph { a -- b }`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.DiagramBlock{
+						Kind:   "dot",
+						Source: "digraph { a -- b }",
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("from block macro", func() {
+			source := `plantuml::diagrams/sequence.puml[sequence,png]`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.DiagramBlock{
+						Kind: "plantuml",
+						Location: &types.Location{
+							Path: "diagrams/sequence.puml",
+						},
+						Attributes: types.Attributes{
+							types.AttrDiagramTarget: "sequence",
+							types.AttrImageFormat:   "png",
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("from block with registered kind", func() {
+			source := `[mermaid]
+----
+graph TD; A-->B;
+----`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.DiagramBlock{
+						Kind:   "mermaid",
+						Source: "graph TD; A-->B;",
+					},
+				},
+			}
+			Expect(ParseDocument(source, configuration.WithDiagramConverter("mermaid", func(source, format string) ([]byte, error) {
+				return nil, nil
+			}))).To(MatchDocument(expected))
+		})
+
+		It("not from block with other style", func() {
+			source := `[source,dot]
+----
+digraph { a -> b }
+----`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.DelimitedBlock{
+						Kind: types.Listing,
+						Attributes: types.Attributes{
+							types.AttrStyle:    types.Source,
+							types.AttrLanguage: "dot",
+						},
+						Elements: []interface{}{
+							&types.StringElement{
+								Content: "digraph { a -",
+							},
+							&types.SpecialCharacter{
+								Name: ">",
+							},
+							&types.StringElement{
+								Content: " b }",
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+	})
+})
@@ -1367,6 +1367,124 @@ lines.
 				}
 				Expect(ParseDocument(source, configuration.WithAttributes(attrs))).To(MatchDocument(expected))
 			})
+
+			It("locked attribute not overridden in document", func() {
+				source := `:icons: image
+
+{icons}`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.AttributeDeclaration{
+							Name:  "icons",
+							Value: "image",
+						},
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.StringElement{Content: "font"},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source, configuration.WithAttribute("icons", "font"))).To(MatchDocument(expected))
+			})
+
+			It("locked attribute not reset in document", func() {
+				source := `:icons!:
+
+{icons}`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.AttributeReset{
+							Name: "icons",
+						},
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.StringElement{Content: "font"},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source, configuration.WithAttribute("icons", "font"))).To(MatchDocument(expected))
+			})
+
+			It("soft-set attribute overridden in document", func() {
+				source := `:icons: image
+
+{icons}`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.AttributeDeclaration{
+							Name:  "icons",
+							Value: "image",
+						},
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.StringElement{Content: "image"},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source, configuration.WithSoftAttribute("icons", "font"))).To(MatchDocument(expected))
+			})
+
+			It("soft-set attribute reset in document", func() {
+				source := `:icons!:
+
+{icons}`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.AttributeReset{
+							Name: "icons",
+						},
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.StringElement{Content: "{icons}"},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source, configuration.WithAttributeOverride("icons=font@"))).To(MatchDocument(expected))
+			})
+
+			It("unset attribute not set in document", func() {
+				source := `:icons: image
+
+{icons}`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.AttributeDeclaration{
+							Name:  "icons",
+							Value: "image",
+						},
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.StringElement{Content: "{icons}"},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source, configuration.WithAttributeOverride("icons!"))).To(MatchDocument(expected))
+			})
+
+			It("attribute with equal sign in its value", func() {
+				source := `:icons: image
+
+{icons}`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.AttributeDeclaration{
+							Name:  "icons",
+							Value: "image",
+						},
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.StringElement{Content: "a=b"},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source, configuration.WithAttributeOverride("icons=a=b"))).To(MatchDocument(expected))
+			})
 		})
 	})
 })
@@ -18,11 +18,20 @@ import (
 
 // Preprocess reads line by line to look-up and process file inclusions and conditionals (`ifdef`, `ifndef` and `ifeval`)
 func Preprocess(source io.Reader, config *configuration.Configuration, opts ...Option) (string, error) {
+	content, _, err := PreprocessWithSourceMap(source, config, opts...)
+	return content, err
+}
+
+// PreprocessWithSourceMap same as `Preprocess`, but also returns the origin (file and line) of each line of the result,
+// which can be passed to `ParseDocument` with the `WithSourceMap` option
+func PreprocessWithSourceMap(source io.Reader, config *configuration.Configuration, opts ...Option) (string, types.SourceMap, error) {
 	ctx := NewParseContext(config, opts...) // each pipeline step will have its own clone of `ctx`
-	return preprocess(ctx, source)
+	return preprocess(ctx, source, nil)
 }
 
-func preprocess(ctx *ParseContext, source io.Reader) (string, error) {
+// preprocess processes the given source, whose lines are numbered sequentially unless `lines` is not nil
+// (eg: when only some lines of a file are included)
+func preprocess(ctx *ParseContext, source io.Reader, lines []int) (string, types.SourceMap, error) {
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debugf("preprocessing file inclusions in %s with leveloffset=%s", ctx.filename, spew.Sdump(ctx.levelOffsets))
 	}
@@ -32,8 +41,17 @@ func preprocess(ctx *ParseContext, source io.Reader) (string, error) {
 	c := conditions{}
 	scanner := bufio.NewScanner(source)
 	t := newBlockDelimiterTracker()
+	number := 0
 	for scanner.Scan() {
 		line := scanner.Bytes()
+		number++
+		b.origin = types.SourcePosition{
+			File: sourceFile(ctx.filename),
+			Line: number,
+		}
+		if number <= len(lines) {
+			b.origin.Line = lines[number-1]
+		}
 		element, err := Parse("", line, append(ctx.opts, Entrypoint("DocumentRawLine"))...)
 		if err != nil {
 			// log.Error(err)
@@ -53,11 +71,16 @@ func preprocess(ctx *ParseContext, source io.Reader) (string, error) {
 			case *types.RawSection:
 				b.WriteString(ctx.levelOffsets.apply(e))
 			case *types.FileInclusion:
-				f, err := includeFile(ctx.Clone(), e)
+				ctx.position = &types.SourcePosition{
+					File:   b.origin.File,
+					Line:   b.origin.Line,
+					Column: 1,
+				}
+				f, m, err := includeFile(ctx.Clone(), e)
 				if err != nil {
-					return "", err
+					return "", nil, err
 				}
-				b.WriteString(f)
+				b.writeIncluded(f, m)
 			case *types.BlockDelimiter:
 				t.track(e.Kind, e.Length)
 				ctx.opts = append(ctx.opts, t.withinDelimitedBlock())
@@ -73,11 +96,11 @@ func preprocess(ctx *ParseContext, source io.Reader) (string, error) {
 			case *types.EndOfCondition:
 				b.enabled = c.pop()
 			default:
-				return "", fmt.Errorf("unexpected type of element while preprocessinh document: '%T'", e)
+				return "", nil, fmt.Errorf("unexpected type of element while preprocessinh document: '%T'", e)
 			}
 		}
 	}
-	return b.String(), nil
+	return b.String(), b.sourceMap, nil
 }
 
 type blockDelimiterTracker struct {
@@ -117,30 +140,53 @@ func (t *blockDelimiterTracker) withinDelimitedBlock() Option {
 // note: there is a trade-off here: we include the whole content of the file in the current
 // fragment, making it potentially big, but at the same time we ensure that the context
 // of the inclusion (for example, within a delimited block) is not lost.
-func includeFile(ctx *ParseContext, incl *types.FileInclusion) (string, error) {
+func includeFile(ctx *ParseContext, incl *types.FileInclusion) (string, types.SourceMap, error) {
 	ctx.opts = append(ctx.opts, GlobalStore(documentHeaderKey, false))
 	if l, ok := incl.GetLocation().Path.([]interface{}); ok {
 		l, err := replaceAttributeRefsInSlicedValue(ctx, l)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 		incl.GetLocation().SetPath(l)
 	}
+	if ctx.safeMode >= configuration.Secure {
+		// as done by Asciidoctor, the file inclusion is replaced by a link to the file
+		path := incl.Location.ToString()
+		log.Warnf("include of '%s' is disabled in the '%s' safe mode", path, ctx.safeMode)
+		ctx.diagnostics.Warnf(types.RestrictedAccess, ctx.position, "include of '%s' is disabled in the '%s' safe mode", path, ctx.safeMode)
+		return "link:" + path + "[role=include]", nil, nil
+	}
+	if err := ctx.safeMode.CheckAccess(ctx.jailDir, pathOf(ctx, incl)); err != nil {
+		// as done by Asciidoctor, the file inclusion is replaced by a warning in the document
+		log.Warnf("Unresolved directive in %s - %s: %s", ctx.filename, incl.RawText, err.Error())
+		ctx.diagnostics.Warnf(types.RestrictedAccess, ctx.position, "Unresolved directive in %s - %s: %s", ctx.filename, incl.RawText, err.Error())
+		return "Unresolved directive in " + ctx.filename + " - " + incl.RawText, nil, nil
+	}
 	content, adoc, err := contentOf(ctx, incl)
 	if err != nil {
-		return "", err
+		ctx.diagnostics.Errorf(types.UnresolvedInclude, ctx.position, "%s", err.Error())
+		return "", nil, err
 	}
 	if !adoc {
-		return string(content), nil
+		m := make(types.SourceMap, len(content.lines))
+		for i, l := range content.lines {
+			m[i] = types.SourcePosition{
+				File: sourceFile(ctx.filename),
+				Line: l,
+			}
+		}
+		return content.String(), m, nil
 	}
 	ctx.opts = append(ctx.opts, sectionEnabled())
-	return preprocess(ctx, strings.NewReader(content))
+	return preprocess(ctx, strings.NewReader(content.String()), content.lines)
 }
 
 type builder struct {
 	strings.Builder
-	insertLF bool
-	enabled  bool
+	insertLF  bool
+	enabled   bool
+	origin    types.SourcePosition // origin of the line being processed
+	sourceMap types.SourceMap
 }
 
 func (b *builder) WriteString(s string) {
@@ -149,6 +195,7 @@ func (b *builder) WriteString(s string) {
 	}
 	b.doInsertLF()
 	b.Builder.WriteString(s)
+	b.sourceMap = append(b.sourceMap, b.origin)
 }
 
 func (b *builder) Write(p []byte) {
@@ -157,6 +204,25 @@ func (b *builder) Write(p []byte) {
 	}
 	b.doInsertLF()
 	b.Builder.Write(p)
+	b.sourceMap = append(b.sourceMap, b.origin)
+}
+
+// writeIncluded writes the content of an included file, along with its source map.
+// Lines which are not covered by the source map (eg: a trailing empty line) are mapped
+// to the origin of the line being processed (ie, the `include::` directive)
+func (b *builder) writeIncluded(s string, m types.SourceMap) {
+	if !b.enabled {
+		return
+	}
+	b.doInsertLF()
+	b.Builder.WriteString(s)
+	for i := 0; i <= strings.Count(s, "\n"); i++ {
+		if i < len(m) {
+			b.sourceMap = append(b.sourceMap, m[i])
+		} else {
+			b.sourceMap = append(b.sourceMap, b.origin)
+		}
+	}
 }
 
 func (b *builder) doInsertLF() {
@@ -212,42 +278,72 @@ func (c *conditions) eval() bool {
 	return true
 }
 
-func contentOf(ctx *ParseContext, incl *types.FileInclusion) (string, bool, error) {
-	path := incl.Location.ToString()
+// fileContent the content of a file to include, along with the number of each line in the file
+type fileContent struct {
+	strings.Builder
+	lines []int
+}
+
+func (c *fileContent) writeLine(line []byte, number int) error {
+	if _, err := c.Write(line); err != nil {
+		return err
+	}
+	if _, err := c.WriteString("\n"); err != nil {
+		return err
+	}
+	c.lines = append(c.lines, number)
+	return nil
+}
+
+// pathOf returns the path to the file to include, relative to the current document
+// (or to the base directory if the document was read from a stream), unless it is absolute
+func pathOf(ctx *ParseContext, incl *types.FileInclusion) string {
+	if path := incl.Location.ToString(); filepath.IsAbs(path) {
+		return path
+	}
 	currentDir := filepath.Dir(ctx.filename)
-	filename := filepath.Join(currentDir, path)
+	if ctx.filename == "" && ctx.baseDir != "" {
+		// document read from a stream (eg: STDIN)
+		currentDir = ctx.baseDir
+	}
+	return filepath.Join(currentDir, incl.Location.ToString())
+}
+
+func contentOf(ctx *ParseContext, incl *types.FileInclusion) (*fileContent, bool, error) {
+	path := incl.Location.ToString()
+	filename := pathOf(ctx, incl)
 
 	f, absPath, closeFile, err := open(filename)
 	defer closeFile()
 	if err != nil {
-		return "", false, errors.Wrapf(err, "Unresolved directive in %s - %s", ctx.filename, incl.RawText)
+		return nil, false, errors.Wrapf(err, "Unresolved directive in %s - %s", ctx.filename, incl.RawText)
 	}
-	result := &strings.Builder{}
+	result := &fileContent{}
 	scanner := bufio.NewScanner(bufio.NewReader(f))
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debugf("reading %s", filename)
 	}
 	if lr, ok, err := lineRanges(incl); err != nil {
-		return "", false, errors.Wrapf(err, "Unresolved directive in %s - %s", ctx.filename, incl.RawText)
+		return nil, false, errors.Wrapf(err, "Unresolved directive in %s - %s", ctx.filename, incl.RawText)
 	} else if ok {
 		if err := readWithinLines(scanner, result, lr); err != nil {
-			return "", false, errors.Wrapf(err, "Unresolved directive in %s - %s", ctx.filename, incl.RawText)
+			return nil, false, errors.Wrapf(err, "Unresolved directive in %s - %s", ctx.filename, incl.RawText)
 		}
 	} else if tr, ok, err := tagRanges(incl); err != nil {
-		return "", false, errors.Wrapf(err, "Unresolved directive in %s - %s", ctx.filename, incl.RawText)
+		return nil, false, errors.Wrapf(err, "Unresolved directive in %s - %s", ctx.filename, incl.RawText)
 	} else if ok {
-		if err := readWithinTags(path, scanner, result, tr); err != nil {
-			return "", false, errors.Wrapf(err, "Unresolved directive in %s - %s", ctx.filename, incl.RawText)
+		if err := readWithinTags(ctx, path, absPath, scanner, result, tr); err != nil {
+			return nil, false, errors.Wrapf(err, "Unresolved directive in %s - %s", ctx.filename, incl.RawText)
 		}
 	} else {
 		if err := readAll(scanner, result); err != nil {
 			log.Error(err)
-			return "", false, errors.Errorf("Unresolved directive in %s - %s", ctx.filename, incl.RawText)
+			return nil, false, errors.Errorf("Unresolved directive in %s - %s", ctx.filename, incl.RawText)
 		}
 	}
 	if err := scanner.Err(); err != nil {
 		log.Error(err)
-		return "", false, errors.Errorf("Unresolved directive in %s - %s", ctx.filename, incl.RawText)
+		return nil, false, errors.Errorf("Unresolved directive in %s - %s", ctx.filename, incl.RawText)
 	}
 	// cloning the context to avoid altering the original as we process recursively embedded file inclusions
 	ctx.filename = absPath
@@ -259,7 +355,7 @@ func contentOf(ctx *ParseContext, incl *types.FileInclusion) (string, bool, erro
 		offset, err := strconv.Atoi(lvl)
 		if err != nil {
 			log.Error(err)
-			return "", false, errors.Errorf("Unresolved directive in %s - %s", ctx.filename, incl.RawText)
+			return nil, false, errors.Errorf("Unresolved directive in %s - %s", ctx.filename, incl.RawText)
 		}
 		if strings.HasPrefix(lvl, "+") || strings.HasPrefix(lvl, "-") {
 			ctx.levelOffsets = append(ctx.levelOffsets, relativeOffset(offset))
@@ -270,7 +366,7 @@ func contentOf(ctx *ParseContext, incl *types.FileInclusion) (string, bool, erro
 	// if log.IsLevelEnabled(log.DebugLevel) {
 	// 	log.Debugf("content of '%s':\n%s", absPath, result.String())
 	// }
-	return result.String(), IsAsciidoc(absPath), nil
+	return result, IsAsciidoc(absPath), nil
 }
 
 type levelOffsets []*levelOffset
@@ -355,7 +451,7 @@ func tagRanges(incl *types.FileInclusion) (types.TagRanges, bool, error) {
 
 // TODO: instead of reading and parsing afterwards, simply parse the lines immediately? ie: `readWithinLines` -> `parseWithinLines`
 // (also, use a specific entrypoint if the doc is not a .adoc)
-func readWithinLines(scanner *bufio.Scanner, content *strings.Builder, lineRanges types.LineRanges) error {
+func readWithinLines(scanner *bufio.Scanner, content *fileContent, lineRanges types.LineRanges) error {
 	line := 0
 	for scanner.Scan() {
 		line++
@@ -374,10 +470,7 @@ func readWithinLines(scanner *bufio.Scanner, content *strings.Builder, lineRange
 		}
 		// TODO: stop reading if current line above highest range
 		if lineRanges.Match(line) {
-			if _, err := content.Write(scanner.Bytes()); err != nil {
-				return err
-			}
-			if _, err := content.WriteString("\n"); err != nil {
+			if err := content.writeLine(scanner.Bytes(), line); err != nil {
 				return err
 			}
 		}
@@ -385,7 +478,7 @@ func readWithinLines(scanner *bufio.Scanner, content *strings.Builder, lineRange
 	return nil
 }
 
-func readWithinTags(path string, scanner *bufio.Scanner, content *strings.Builder, expectedRanges types.TagRanges) error {
+func readWithinTags(ctx *ParseContext, path, absPath string, scanner *bufio.Scanner, content *fileContent, expectedRanges types.TagRanges) error {
 	// log.Debugf("limiting to tag ranges: %v", expectedRanges)
 	currentRanges := make(map[string]*types.CurrentTagRange, len(expectedRanges)) // ensure capacity
 	lineNumber := 0
@@ -412,12 +505,7 @@ func readWithinTags(path string, scanner *bufio.Scanner, content *strings.Builde
 			currentRanges[endTag.Value].EndLine = lineNumber
 		}
 		if expectedRanges.Match(lineNumber, currentRanges) && !fl.HasTag() {
-			_, err := content.Write(scanner.Bytes())
-			if err != nil {
-				return err
-			}
-			_, err = content.WriteString("\n")
-			if err != nil {
+			if err := content.writeLine(scanner.Bytes(), lineNumber); err != nil {
 				return err
 			}
 		}
@@ -433,14 +521,21 @@ func readWithinTags(path string, scanner *bufio.Scanner, content *strings.Builde
 				return fmt.Errorf("tag '%s' not found in file to include", tag.Name)
 			} else if tr.EndLine == -1 {
 				log.Warnf("detected unclosed tag '%s' starting at line %d of include file: %s", tag.Name, tr.StartLine, path)
+				ctx.diagnostics.Warnf(types.UnclosedIncludeTag, &types.SourcePosition{
+					File:   absPath,
+					Line:   tr.StartLine,
+					Column: 1,
+				}, "detected unclosed tag '%s' starting at line %d of include file: %s", tag.Name, tr.StartLine, path)
 			}
 		}
 	}
 	return nil
 }
 
-func readAll(scanner *bufio.Scanner, content *strings.Builder) error {
+func readAll(scanner *bufio.Scanner, content *fileContent) error {
+	lineNumber := 0
 	for scanner.Scan() {
+		lineNumber++
 		// parse the line in search for the `tag::<tag>[]` or `end:<tag>[]` macros
 		l, err := Parse("", scanner.Bytes(), Entrypoint("IncludedFileLine"))
 		if err != nil {
@@ -454,12 +549,7 @@ func readAll(scanner *bufio.Scanner, content *strings.Builder) error {
 		if fl.HasTag() {
 			continue
 		}
-		_, err = content.Write(scanner.Bytes())
-		if err != nil {
-			return err
-		}
-		_, err = content.WriteString("\n")
-		if err != nil {
+		if err := content.writeLine(scanner.Bytes(), lineNumber); err != nil {
 			return err
 		}
 	}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -1884,6 +1885,23 @@ ____`
 				Expect(logs).To(ContainJSONLog(log.WarnLevel, "detected unclosed tag 'unclosed' starting at line 6 of include file: ../../test/includes/tag-include-unclosed.adoc"))
 			})
 
+			It("with unclosed tag reported in diagnostics", func() {
+				source := `include::../../test/includes/tag-include-unclosed.adoc[tag=unclosed]`
+				included, err := filepath.Abs("../../test/includes/tag-include-unclosed.adoc")
+				Expect(err).NotTo(HaveOccurred())
+				diagnostics := types.NewDiagnostics()
+				_, err = ParseDocument(source, configuration.WithDiagnostics(diagnostics))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(diagnostics.All()).To(Equal([]types.Diagnostic{
+					{
+						Severity: types.SeverityWarning,
+						Code:     types.UnclosedIncludeTag,
+						Message:  "detected unclosed tag 'unclosed' starting at line 6 of include file: ../../test/includes/tag-include-unclosed.adoc",
+						Position: &types.SourcePosition{File: included, Line: 6, Column: 1},
+					},
+				}))
+			})
+
 			It("with unknown tag", func() {
 				// given
 				source := `include::../../test/includes/tag-include.adoc[tag=unknown]`
@@ -2396,5 +2414,124 @@ include::../../test/includes/hello_world.go.txt[lines=1]
 				Expect(ParseDocument(source)).To(MatchDocument(expected))
 			})
 		})
+
+		Context("with safe mode", func() {
+
+			It("should include file within base dir in safe mode", func() {
+				source := `----
+include::test/includes/hello_world.go.txt[lines=1]
+----`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.DelimitedBlock{
+							Kind: types.Listing,
+							Elements: []interface{}{
+								&types.StringElement{
+									Content: `package includes`,
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source,
+					configuration.WithFilename(filepath.Join("..", "..", "test.adoc")),
+					configuration.WithSafeMode(configuration.Safe),
+				)).To(MatchDocument(expected))
+			})
+
+			It("should not include file outside of document dir in safe mode", func() {
+				source := `include::../../test/includes/chapter-a.adoc[]
+
+a paragraph`
+				diagnostics := types.NewDiagnostics()
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.StringElement{
+									Content: "Unresolved directive in test.adoc - include::../../test/includes/chapter-a.adoc[]",
+								},
+							},
+						},
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.StringElement{
+									Content: "a paragraph",
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source,
+					configuration.WithSafeMode(configuration.Safe),
+					configuration.WithDiagnostics(diagnostics),
+				)).To(MatchDocument(expected))
+				Expect(diagnostics.All()).To(HaveLen(1))
+				Expect(diagnostics.All()[0].Severity).To(Equal(types.SeverityWarning))
+				Expect(diagnostics.All()[0].Code).To(Equal(types.RestrictedAccess))
+				Expect(diagnostics.All()[0].Message).To(ContainSubstring("Unresolved directive in test.adoc - include::../../test/includes/chapter-a.adoc[]: access to '../../test/includes/chapter-a.adoc' is restricted: the file is outside of"))
+			})
+
+			It("should not include file with absolute path outside of base dir in server mode", func() {
+				path, err := filepath.Abs(filepath.Join("..", "..", "test", "includes", "chapter-a.adoc"))
+				Expect(err).NotTo(HaveOccurred())
+				source := "include::" + path + "[]"
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.StringElement{
+									Content: "Unresolved directive in test.adoc - include::" + path + "[]",
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source,
+					configuration.WithBaseDir("."),
+					configuration.WithSafeMode(configuration.Server),
+				)).To(MatchDocument(expected))
+			})
+
+			It("should replace file inclusion with link in secure mode", func() {
+				source := `include::chapter-a.adoc[]`
+				filename, err := filepath.Abs(filepath.Join("..", "..", "test", "includes", "test.adoc"))
+				Expect(err).NotTo(HaveOccurred())
+				diagnostics := types.NewDiagnostics()
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.InlineLink{
+									Location: &types.Location{
+										Path: "chapter-a.adoc",
+									},
+									Attributes: types.Attributes{
+										types.AttrRoles: types.Roles{"include"},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source,
+					configuration.WithFilename(filepath.Join("..", "..", "test", "includes", "test.adoc")),
+					configuration.WithSafeMode(configuration.Secure),
+					configuration.WithDiagnostics(diagnostics),
+				)).To(MatchDocument(expected))
+				Expect(diagnostics.All()).To(Equal([]types.Diagnostic{
+					{
+						Severity: types.SeverityWarning,
+						Code:     types.RestrictedAccess,
+						Message:  "include of 'chapter-a.adoc' is disabled in the 'secure' safe mode",
+						Position: &types.SourcePosition{
+							File:   filename,
+							Line:   1,
+							Column: 1,
+						},
+					},
+				}))
+			})
+		})
 	})
 })
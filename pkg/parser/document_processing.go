@@ -23,9 +23,11 @@ func ParseDocument(r io.Reader, config *configuration.Configuration, opts ...Opt
 		FilterOut(done,
 			ArrangeLists(done,
 				CollectFootnotes(footnotes, done,
-					ApplySubstitutions(NewParseContext(config, opts...), done, // needs to be before 'ArrangeLists'
-						RefineFragments(NewParseContext(config, opts...), r, done,
-							ParseDocumentFragments(NewParseContext(config, opts...), r, done),
+					ApplyExtensions(NewParseContext(config, opts...), done,
+						ApplySubstitutions(NewParseContext(config, opts...), done, // needs to be before 'ArrangeLists'
+							RefineFragments(NewParseContext(config, opts...), r, done,
+								ParseDocumentFragments(NewParseContext(config, opts...), r, done),
+							),
 						),
 					),
 				),
@@ -36,6 +38,10 @@ func ParseDocument(r io.Reader, config *configuration.Configuration, opts ...Opt
 	if err != nil {
 		return nil, err
 	}
+	resolveSourcePositions(doc.Elements, sourceMapOf(opts), sourceFile(config.Filename))
+	for _, f := range footnotes.Unresolved {
+		config.Diagnostics.Warnf(types.UnresolvedFootnoteReference, f.Position, "no footnote with reference '%s'", f.Ref)
+	}
 	if len(footnotes.Notes) > 0 {
 		doc.Footnotes = footnotes.Notes
 	}
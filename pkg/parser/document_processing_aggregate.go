@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -104,6 +105,12 @@ func aggregate(ctx *ParseContext, fragmentStream <-chan types.DocumentFragment)
 	if len(toc.Sections) > 0 {
 		doc.TableOfContents = toc
 	}
+	// also, collect the index terms and set their anchors
+	index := &types.Index{}
+	collectIndexTerms(doc.Elements, index, new(int))
+	if len(index.Entries) > 0 {
+		doc.Index = index
+	}
 	log.WithField("pipeline_task", "aggregate").Debug("done")
 	return doc, nil
 }
@@ -127,6 +134,43 @@ func resolveCrossReferences(element interface{}, attrs *contextAttributes) error
 	return nil
 }
 
+// collectIndexTerms sets the ID of the index terms (`_indexterm_1`, `_indexterm_2`, etc.)
+// and adds them in the given index
+func collectIndexTerms(elements []interface{}, index *types.Index, count *int) {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *types.IndexTerm:
+			*count++
+			e.ID = "_indexterm_" + strconv.Itoa(*count)
+			if term := e.Text(); term != "" {
+				index.Add(e.ID, term)
+			}
+		case *types.ConcealedIndexTerm:
+			*count++
+			e.ID = "_indexterm_" + strconv.Itoa(*count)
+			if terms := e.Terms(); len(terms) > 0 {
+				index.Add(e.ID, terms...)
+			}
+		case *types.Section:
+			collectIndexTerms(e.Title, index, count)
+			collectIndexTerms(e.Elements, index, count)
+		case *types.LabeledListElement:
+			collectIndexTerms(e.Term, index, count)
+			collectIndexTerms(e.Elements, index, count)
+		case *types.Table:
+			if e.Header != nil {
+				collectIndexTerms(e.Header.GetElements(), index, count)
+			}
+			collectIndexTerms(e.GetElements(), index, count)
+			if e.Footer != nil {
+				collectIndexTerms(e.Footer.GetElements(), index, count)
+			}
+		case types.WithElements:
+			collectIndexTerms(e.GetElements(), index, count)
+		}
+	}
+}
+
 type aggregator []types.WithElementAddition
 
 func (a *aggregator) append(e interface{}) error {
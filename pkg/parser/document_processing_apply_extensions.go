@@ -0,0 +1,177 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/diagram"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// ApplyExtensions replaces the blocks and the user macros for which a block processor or a macro processor
+// was registered with the elements returned by these processors, and the diagram blocks and block macros
+// (eg: `[graphviz]` blocks or `plantuml::sequence.puml[]`) with `DiagramBlock` elements
+func ApplyExtensions(ctx *ParseContext, done <-chan interface{}, fragmentStream <-chan types.DocumentFragment) chan types.DocumentFragment {
+	processedFragmentStream := make(chan types.DocumentFragment, bufferSize)
+	go func() {
+		defer close(processedFragmentStream)
+		for f := range fragmentStream {
+			select {
+			case <-done:
+				log.WithField("pipeline_stage", "apply_extensions").Debug("received 'done' signal")
+				return
+			case processedFragmentStream <- applyExtensions(ctx, f):
+			}
+		}
+		log.WithField("pipeline_stage", "apply_extensions").Debug("done")
+	}()
+	return processedFragmentStream
+}
+
+func applyExtensions(ctx *ParseContext, f types.DocumentFragment) types.DocumentFragment {
+	if f.Error != nil {
+		log.Debugf("skipping extensions")
+		return f
+	}
+	v := types.VisitorFuncs{
+		EnterFunc: func(c *types.Cursor) error {
+			switch e := c.Element().(type) {
+			case *types.UserMacro:
+				if p, found := ctx.extensions.MacroProcessors[e.Name]; found {
+					r, err := p(e)
+					if err != nil {
+						return errors.Wrapf(err, "unable to process macro '%s'", e.Name)
+					}
+					replace(c, r)
+				} else if e.Kind == types.BlockMacro && ctx.diagrams[e.Name] {
+					replace(c, types.NewDiagramBlockFromMacro(e.Name, &types.Location{Path: e.Value}, e.Attributes))
+				}
+			case types.WithElements:
+				if p, found := blockProcessorFor(ctx, e); found {
+					r, err := p(e, rawContent(e.GetElements()))
+					if err != nil {
+						return errors.Wrapf(err, "unable to process block with style '%s'", blockStyle(e))
+					}
+					replace(c, r)
+				} else if isDiagram(ctx, e) {
+					replace(c, types.NewDiagramBlock(blockStyle(e), rawContent(e.GetElements()), e.GetAttributes()))
+				}
+			}
+			return nil
+		},
+	}
+	// temporary container, so that the top-level elements of the fragment can also be replaced or deleted
+	c := &types.ListElements{
+		Elements: f.Elements,
+	}
+	if err := types.Walk(c, v); err != nil {
+		return types.NewErrorFragment(f.Position, err)
+	}
+	f.Elements = c.Elements
+	return f
+}
+
+// replace replaces the element at the cursor with the given replacement (or deletes it if the replacement is `nil`),
+// and skips the children of the replacement, which were produced by the extension
+func replace(c *types.Cursor, r interface{}) {
+	if r == nil {
+		c.Delete()
+		return
+	}
+	// retain the position of the replaced element, to report diagnostics
+	if p, ok := c.Element().(types.WithSourcePosition); ok {
+		if rp, ok := r.(types.WithSourcePosition); ok && rp.GetSourcePosition() == nil {
+			rp.SetSourcePosition(p.GetSourcePosition())
+		}
+	}
+	c.Replace(r)
+	c.SkipChildren()
+}
+
+// blockProcessorFor returns the block processor registered for the style of the given paragraph or delimited block, if any
+func blockProcessorFor(ctx *ParseContext, b types.WithElements) (configuration.BlockProcessor, bool) {
+	if len(ctx.extensions.BlockProcessors) == 0 {
+		return nil, false
+	}
+	switch b.(type) {
+	case *types.Paragraph, *types.DelimitedBlock:
+		p, found := ctx.extensions.BlockProcessors[blockStyle(b)]
+		return p, found
+	default:
+		return nil, false
+	}
+}
+
+// diagramKinds returns the kinds of diagrams which are recognized, ie, the known kinds and the kinds
+// for which a converter is registered
+func diagramKinds(config *configuration.Configuration) map[string]bool {
+	kinds := make(map[string]bool, len(diagram.Kinds)+len(config.DiagramConverters))
+	for _, k := range diagram.Kinds {
+		kinds[k] = true
+	}
+	for k := range config.DiagramConverters {
+		kinds[k] = true
+	}
+	return kinds
+}
+
+// isDiagram returns `true` if the given block is a paragraph or a listing, literal, fenced or passthrough block
+// whose style is a kind of diagram (eg: `[graphviz]`)
+func isDiagram(ctx *ParseContext, b types.WithElements) bool {
+	return hasVerbatimContent(b) && ctx.diagrams[blockStyle(b)]
+}
+
+// hasRawContent returns `true` if the content of the given block must be kept as-is, ie, without any parsing
+// nor substitution, because it is given to a block processor or it is the source of a diagram
+func hasRawContent(ctx *ParseContext, b types.WithElements) bool {
+	if _, found := blockProcessorFor(ctx, b); found {
+		return true
+	}
+	return isDiagram(ctx, b)
+}
+
+// blockStyle returns the style of the given block, which may not have been resolved yet
+// (ie, which may still be the first positional attribute)
+func blockStyle(b types.WithAttributes) string {
+	attrs := b.GetAttributes()
+	if style, found := attrs.GetAsString(types.AttrStyle); found {
+		return style
+	}
+	return attrs.GetAsStringWithDefault(types.AttrPositional1, "")
+}
+
+// hasVerbatimContent returns `true` if the given block is a paragraph or a delimited block whose content
+// is not made of other blocks
+func hasVerbatimContent(b types.WithElements) bool {
+	switch b := b.(type) {
+	case *types.Paragraph:
+		return true
+	case *types.DelimitedBlock:
+		switch b.Kind {
+		case types.Listing, types.Literal, types.Fenced, types.Passthrough:
+			return true
+		}
+	}
+	return false
+}
+
+// rawContent returns the text of the given elements
+func rawContent(elements []interface{}) string {
+	buf := &strings.Builder{}
+	for _, e := range elements {
+		switch e := e.(type) {
+		case *types.StringElement:
+			buf.WriteString(e.Content)
+		case *types.SpecialCharacter:
+			buf.WriteString(e.Name)
+		case *types.RawLine:
+			buf.WriteString(e.Content)
+		case string:
+			buf.WriteString(e)
+		}
+	}
+	return buf.String()
+}
@@ -60,6 +60,13 @@ func applySubstitutionsOnElement(ctx *ParseContext, element interface{}, opts ..
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debugf("applying substitutions on element of type '%T'", element)
 	}
+	// keep track of the position of the element, in case a problem is reported
+	if e, ok := element.(types.WithSourcePosition); ok && e.GetSourcePosition() != nil {
+		defer func(position *types.SourcePosition) {
+			ctx.position = position
+		}(ctx.position)
+		ctx.position = e.GetSourcePosition()
+	}
 	switch b := element.(type) {
 	case *types.FrontMatter:
 		ctx.attributes.setAll(b.Attributes)
@@ -225,6 +232,11 @@ func applySubstitutionsOnWithElements(ctx *ParseContext, b types.WithElements, o
 	if err != nil {
 		return err
 	}
+	raw := hasRawContent(ctx, b)
+	if raw && !b.GetAttributes().Has(types.AttrSubstitutions) {
+		// the content of the block is given as-is to its processor, or is the source of a diagram
+		subs = noneSubstitutions()
+	}
 	opts = append(opts, Entrypoint("NormalGroup")) // TODO: move this into NewParseContext ?
 	switch b := b.(type) {
 	case *types.LabeledListElement:
@@ -235,6 +247,11 @@ func applySubstitutionsOnWithElements(ctx *ParseContext, b types.WithElements, o
 	case *types.DelimitedBlock:
 		switch b.Kind {
 		case types.Example, types.Quote, types.Sidebar, types.Open: // TODO: add a func on *types.DelimitedBlock to avoid checking the exact same kinds in multiple places
+			if raw {
+				// the content of the block was not parsed
+				b.Elements, err = applySubstitutionsOnSlice(ctx, b.Elements, subs, opts...)
+				return err
+			}
 			return applySubstitutionsOnElements(ctx, b.Elements, opts...)
 		case types.MarkdownQuote:
 			var attribution string
@@ -551,6 +568,7 @@ func valueForAttributeRef(ctx *ParseContext, a *types.AttributeReference) (inter
 	v, found := ctx.attributes.get(a.Name)
 	if !found {
 		log.Warnf("unable to find entry for attribute with key '%s' in context", a.Name)
+		ctx.diagnostics.Warnf(types.MissingAttribute, ctx.position, "unable to find entry for attribute with key '%s' in context", a.Name)
 		return "{" + a.Name + "}", false, nil
 	}
 	switch v := v.(type) {
@@ -715,6 +733,8 @@ func serializePlainText(content interface{}) (string, error) {
 					return "", err
 				}
 				result.WriteString(c) // reserialize, so we can detect bare URLs (eg: `a link to <{base_url}>`)
+			case *types.InlineStem:
+				result.WriteString(element.Content)
 			default:
 				return "", fmt.Errorf("unexpected type of content to serialize as plain text: %T", element)
 			}
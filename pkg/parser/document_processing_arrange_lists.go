@@ -58,6 +58,12 @@ func arrangeListElements(elements []interface{}) ([]interface{}, error) {
 				return nil, err
 			}
 			result = append(result, e)
+		case *types.Table:
+			log.Debug("checking elements in Table")
+			if err := arrangeTableListElements(e); err != nil {
+				return nil, err
+			}
+			result = append(result, e)
 		case *types.ListElements:
 			log.Debug("arranging list elements in ListElements")
 			l, err := doArrangeListElements(e.Elements)
@@ -75,6 +81,27 @@ func arrangeListElements(elements []interface{}) ([]interface{}, error) {
 	return result, nil
 }
 
+// arranges the list elements in the cells of the given table (ie, cells with AsciiDoc content)
+func arrangeTableListElements(t *types.Table) error {
+	rows := make([]*types.TableRow, 0, len(t.Rows)+2)
+	if t.Header != nil {
+		rows = append(rows, t.Header)
+	}
+	rows = append(rows, t.Rows...)
+	if t.Footer != nil {
+		rows = append(rows, t.Footer)
+	}
+	for _, r := range rows {
+		for _, c := range r.Cells {
+			var err error
+			if c.Elements, err = arrangeListElements(c.Elements); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func doArrangeListElements(elements []interface{}) (interface{}, error) {
 	lists := newListStack() // so we can support delimited blocks in list elements, etc.
 
@@ -32,7 +32,14 @@ func collectFootnotes(n *types.Footnotes, f types.DocumentFragment) types.Docume
 			log.Debugf("collecting footnotes in element of type '%T'", e)
 		}
 		if e, ok := e.(types.WithFootnotes); ok {
+			unresolved := len(n.Unresolved)
 			e.SubstituteFootnotes(n)
+			// keep track of the element in which the unresolved references were found
+			if p, ok := e.(types.WithSourcePosition); ok {
+				for i := unresolved; i < len(n.Unresolved); i++ {
+					n.Unresolved[i].Position = p.GetSourcePosition()
+				}
+			}
 		}
 	}
 	return f
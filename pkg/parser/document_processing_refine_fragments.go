@@ -104,17 +104,32 @@ func reparseTableCell(ctx *ParseContext, c *types.TableCell) error {
 	log.Debugf("reparsing content of table cell")
 	switch c.Format {
 	case "a":
-		opts := append(ctx.opts, Entrypoint("DelimitedBlockElements"))
+		opts := append(ctx.opts, Entrypoint("DelimitedBlockElements"), withLineOffset(lineOf(c)-1), withColumnOffset(columnOf(c)-1))
 		elements, err := reparseElements(c.Elements, opts...)
 		if err != nil {
 			return err
 		}
 		c.Elements = elements
+		for _, e := range c.Elements {
+			if err := refineElement(ctx, e); err != nil {
+				return err
+			}
+		}
+	case "l":
+		// wrap in a literal block, to retain the line breaks and spaces
+		c.Elements = []interface{}{
+			&types.DelimitedBlock{
+				Kind:           types.Literal,
+				Elements:       c.Elements,
+				SourcePosition: positionOf(c),
+			},
+		}
 	default:
 		// wrap in a paragraph
 		c.Elements = []interface{}{
 			&types.Paragraph{
-				Elements: c.Elements,
+				Elements:       c.Elements,
+				SourcePosition: positionOf(c),
 			},
 		}
 	}
@@ -123,10 +138,15 @@ func reparseTableCell(ctx *ParseContext, c *types.TableCell) error {
 }
 
 func reparseDelimitedBlock(ctx *ParseContext, b *types.DelimitedBlock) error {
+	if hasRawContent(ctx, b) {
+		// the content of the block is given as-is to its processor
+		return nil
+	}
 	switch b.Kind {
 	case types.Example, types.Quote, types.Sidebar, types.Open:
 		log.Debugf("parsing elements of delimited block of kind '%s'", b.Kind)
-		opts := append(ctx.opts, Entrypoint("DelimitedBlockElements"))
+		// content starts on the line after the block delimiter
+		opts := append(ctx.opts, Entrypoint("DelimitedBlockElements"), withLineOffset(lineOf(b)))
 		elements, err := reparseElements(b.Elements, opts...)
 		if err != nil {
 			return err
@@ -0,0 +1,164 @@
+package parser
+
+import (
+	"path/filepath"
+	"reflect"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+const lineOffsetKey = "line_offset"
+
+const columnOffsetKey = "column_offset"
+
+const sourceMapKey = "source_map"
+
+// WithSourceMap returns an option to resolve the position of the elements in the file(s) they originate from,
+// given the source map of the preprocessed document (see `PreprocessWithSourceMap`)
+func WithSourceMap(m types.SourceMap) Option {
+	return GlobalStore(sourceMapKey, m)
+}
+
+// withLineOffset returns an option to shift the line of the elements when parsing a portion of the document
+// (eg: the content of a delimited block, which starts on the line after the given line)
+func withLineOffset(offset int) Option {
+	return GlobalStore(lineOffsetKey, offset)
+}
+
+// withColumnOffset returns an option to shift the column of the elements on the first line when parsing a portion of the document
+// (eg: the content of an AsciiDoc table cell, which starts after the cell separator)
+func withColumnOffset(offset int) Option {
+	return GlobalStore(columnOffsetKey, offset)
+}
+
+// withSourcePosition sets the position of the given element (if applicable), based on the start of the current match
+func (c *current) withSourcePosition(element interface{}, err error) (interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	c.setSourcePosition(element, c.pos.line, c.pos.col)
+	return element, nil
+}
+
+// withSourcePositionAt sets the position of the given element (if applicable) at the given position of the parser
+// (see the `CurrentPosition` rule)
+func (c *current) withSourcePositionAt(element interface{}, err error, pos interface{}) (interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := pos.(position); ok {
+		c.setSourcePosition(element, p.line, p.col)
+	}
+	return element, nil
+}
+
+// setSourcePosition sets the position of the given element (if applicable) in the preprocessed document.
+// The actual file and line are resolved once the whole document has been parsed
+func (c *current) setSourcePosition(element interface{}, line, col int) {
+	// also ignore typed `nil` elements (eg: a `(*types.Table)(nil)` returned along with an error)
+	if e, ok := element.(types.WithSourcePosition); ok && !reflect.ValueOf(e).IsNil() {
+		line, col = c.applyOffsets(line, col)
+		e.SetSourcePosition(&types.SourcePosition{
+			Line:   line,
+			Column: col,
+		})
+	}
+}
+
+// sourcePosition returns the position of the last match in the file it originates from
+func (c *current) sourcePosition() *types.SourcePosition {
+	line, col := c.applyOffsets(c.pos.line, c.pos.col)
+	filename, _ := c.globalStore[filenameKey].(string)
+	m, _ := c.globalStore[sourceMapKey].(types.SourceMap)
+	file, line := m.Resolve(filename, line)
+	return &types.SourcePosition{
+		File:   file,
+		Line:   line,
+		Column: col,
+	}
+}
+
+// applyOffsets shifts the given line and column when parsing a portion of the document (see `withLineOffset` and `withColumnOffset`)
+func (c *current) applyOffsets(line, col int) (int, int) {
+	if offset, ok := c.globalStore[columnOffsetKey].(int); ok && line == 1 {
+		col += offset
+	}
+	if offset, ok := c.globalStore[lineOffsetKey].(int); ok {
+		line += offset
+	}
+	return line, col
+}
+
+// sourceFile returns the absolute path of the given file (if not empty), so that the positions of the elements
+// of the document and of the included files (whose paths are absolute) are reported in the same way
+func sourceFile(filename string) string {
+	if filename == "" {
+		return ""
+	}
+	if path, err := filepath.Abs(filename); err == nil {
+		return path
+	}
+	return filename
+}
+
+// positionOf returns a copy of the position of the given element, or `nil` if it is unknown
+func positionOf(element types.WithSourcePosition) *types.SourcePosition {
+	if p := element.GetSourcePosition(); p != nil {
+		result := *p
+		return &result
+	}
+	return nil
+}
+
+// lineOf returns the line of the given element in the preprocessed document, or `0` if it is unknown
+func lineOf(element types.WithSourcePosition) int {
+	if p := element.GetSourcePosition(); p != nil {
+		return p.Line
+	}
+	return 0
+}
+
+// columnOf returns the column of the given element, or `1` if it is unknown
+func columnOf(element types.WithSourcePosition) int {
+	if p := element.GetSourcePosition(); p != nil {
+		return p.Column
+	}
+	return 1
+}
+
+// sourceMapOf returns the source map set with the `WithSourceMap` option, if any
+func sourceMapOf(opts []Option) types.SourceMap {
+	p := newParser("", nil, opts...)
+	m, _ := p.cur.globalStore[sourceMapKey].(types.SourceMap)
+	return m
+}
+
+// resolveSourcePositions replaces the line of each element in the preprocessed document with the file and line
+// where the element actually is, using the given source map (or the given filename if there is no source map)
+func resolveSourcePositions(elements []interface{}, m types.SourceMap, filename string) {
+	for _, e := range elements {
+		if e, ok := e.(types.WithSourcePosition); ok {
+			if p := e.GetSourcePosition(); p != nil && p.File == "" {
+				p.File, p.Line = m.Resolve(filename, p.Line)
+			}
+		}
+		switch e := e.(type) {
+		case *types.Paragraph:
+			// no block element within a paragraph
+		case *types.Preamble:
+			resolveSourcePositions(e.Elements, m, filename)
+		case *types.Table:
+			if e.Header != nil {
+				resolveSourcePositions(e.Header.GetElements(), m, filename)
+			}
+			for _, r := range e.Rows {
+				resolveSourcePositions(r.GetElements(), m, filename)
+			}
+			if e.Footer != nil {
+				resolveSourcePositions(e.Footer.GetElements(), m, filename)
+			}
+		case types.WithElements:
+			resolveSourcePositions(e.GetElements(), m, filename)
+		}
+	}
+}
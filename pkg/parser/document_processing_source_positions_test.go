@@ -0,0 +1,172 @@
+package parser_test
+
+import (
+	"path/filepath"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("source positions", func() {
+
+	var filename string // absolute path of the document, as for the included files
+
+	BeforeEach(func() {
+		var err error
+		filename, err = filepath.Abs("test.adoc")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should set positions of sections and paragraphs", func() {
+		source := `== Section A
+
+a paragraph
+
+=== Section A.1
+
+another paragraph`
+		doc, err := ParseDocument(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Elements).To(HaveLen(1))
+		sectionA := doc.Elements[0].(*types.Section)
+		Expect(sectionA.GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 1, Column: 1}))
+		Expect(sectionA.Elements[0].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 3, Column: 1}))
+		sectionA1 := sectionA.Elements[1].(*types.Section)
+		Expect(sectionA1.GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 5, Column: 1}))
+		Expect(sectionA1.Elements[0].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 7, Column: 1}))
+	})
+
+	It("should set positions of elements within delimited blocks", func() {
+		source := `a paragraph
+
+====
+first paragraph
+
+second paragraph
+====`
+		doc, err := ParseDocument(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Elements).To(HaveLen(2))
+		block := doc.Elements[1].(*types.DelimitedBlock)
+		Expect(block.GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 3, Column: 1}))
+		Expect(block.Elements).To(HaveLen(2))
+		Expect(block.Elements[0].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 4, Column: 1}))
+		Expect(block.Elements[1].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 6, Column: 1}))
+	})
+
+	It("should set positions of lists and list elements", func() {
+		source := `* item 1
+* item 2
+
+a paragraph
+
+. step 1
+. step 2`
+		doc, err := ParseDocument(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Elements).To(HaveLen(3))
+		unordered := doc.Elements[0].(*types.List)
+		Expect(unordered.GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 1, Column: 1}))
+		Expect(unordered.Elements[1].(*types.UnorderedListElement).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 2, Column: 1}))
+		ordered := doc.Elements[2].(*types.List)
+		Expect(ordered.GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 6, Column: 1}))
+		Expect(ordered.Elements[1].(*types.OrderedListElement).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 7, Column: 1}))
+	})
+
+	It("should set positions of paragraphs in list elements", func() {
+		source := `* item 1
++
+continuation`
+		doc, err := ParseDocument(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Elements).To(HaveLen(1))
+		element := doc.Elements[0].(*types.List).Elements[0].(*types.UnorderedListElement)
+		Expect(element.Elements).To(HaveLen(2))
+		Expect(element.Elements[0].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 1, Column: 3}))
+		Expect(element.Elements[1].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 3, Column: 1}))
+	})
+
+	It("should set positions of paragraphs in labeled list elements", func() {
+		source := `term:: description`
+		doc, err := ParseDocument(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Elements).To(HaveLen(1))
+		element := doc.Elements[0].(*types.List).Elements[0].(*types.LabeledListElement)
+		Expect(element.Elements[0].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 1, Column: 8}))
+	})
+
+	It("should set positions of tables and cells", func() {
+		source := `|===
+| A | B
+
+| C
+| D
+|===`
+		doc, err := ParseDocument(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Elements).To(HaveLen(1))
+		table := doc.Elements[0].(*types.Table)
+		Expect(table.GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 1, Column: 1}))
+		// the position of a cell is the position of its content
+		Expect(table.Header.Cells[1].GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 2, Column: 7}))
+		Expect(table.Rows[0].Cells[0].GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 4, Column: 3}))
+		Expect(table.Rows[0].Cells[1].GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 5, Column: 3}))
+	})
+
+	It("should set positions of the content of cells", func() {
+		source := `|===
+| A l| B
+|===`
+		doc, err := ParseDocument(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Elements).To(HaveLen(1))
+		cells := doc.Elements[0].(*types.Table).Rows[0].Cells
+		Expect(cells[0].Elements[0].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 2, Column: 3}))
+		Expect(cells[1].Elements[0].(*types.DelimitedBlock).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 2, Column: 8}))
+	})
+
+	It("should set positions of the content of AsciiDoc cells", func() {
+		source := `[cols="1,1"]
+|===
+| A a| first paragraph
+
+second paragraph
+a|
+third paragraph
+| D
+|===`
+		doc, err := ParseDocument(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Elements).To(HaveLen(1))
+		rows := doc.Elements[0].(*types.Table).Rows
+		Expect(rows).To(HaveLen(2))
+		cell := rows[0].Cells[1]
+		Expect(cell.Elements).To(HaveLen(2))
+		Expect(cell.Elements[0].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 3, Column: 8}))
+		Expect(cell.Elements[1].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 5, Column: 1}))
+		// content on the line after the separator
+		Expect(rows[1].Cells[0].Elements[0].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 7, Column: 1}))
+	})
+
+	It("should set positions of elements in included files", func() {
+		source := `a paragraph
+
+include::../../test/includes/grandchild-include.adoc[]
+
+last paragraph`
+		included, err := filepath.Abs("../../test/includes/grandchild-include.adoc")
+		Expect(err).NotTo(HaveOccurred())
+		doc, err := ParseDocument(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Elements).To(HaveLen(2))
+		Expect(doc.Elements[0].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 1, Column: 1}))
+		section := doc.Elements[1].(*types.Section)
+		Expect(section.GetSourcePosition()).To(Equal(&types.SourcePosition{File: included, Line: 1, Column: 1}))
+		Expect(section.Elements).To(HaveLen(3))
+		Expect(section.Elements[1].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: included, Line: 5, Column: 1}))
+		Expect(section.Elements[2].(*types.Paragraph).GetSourcePosition()).To(Equal(&types.SourcePosition{File: filename, Line: 5, Column: 1}))
+	})
+})
@@ -148,7 +148,7 @@ func defaultSubstitutions(b types.WithElements) *substitutions {
 		switch b.GetAttributes().GetAsStringWithDefault(types.AttrStyle, "") {
 		case types.Listing:
 			return verbatimSubstitutions()
-		case types.Passthrough:
+		case types.Passthrough, types.Stem, types.LatexMath, types.AsciiMath:
 			return noneSubstitutions()
 		default:
 			return normalSubstitutions()
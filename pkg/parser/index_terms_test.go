@@ -22,6 +22,7 @@ var _ = Describe("index terms", func() {
 								Content: "a paragraph with an ",
 							},
 							&types.IndexTerm{
+								ID: "_indexterm_1",
 								Term: []interface{}{
 									&types.StringElement{
 										Content: "index",
@@ -34,6 +35,14 @@ var _ = Describe("index terms", func() {
 						},
 					},
 				},
+				Index: &types.Index{
+					Entries: []*types.IndexEntry{
+						{
+							Term:    "index",
+							Anchors: []string{"_indexterm_1"},
+						},
+					},
+				},
 			}
 			Expect(ParseDocument(source)).To(MatchDocument(expected))
 		})
@@ -46,6 +55,7 @@ a paragraph with an index term.`
 					&types.Paragraph{
 						Elements: []interface{}{
 							&types.IndexTerm{
+								ID: "_indexterm_1",
 								Term: []interface{}{
 									&types.StringElement{
 										Content: "foo_bar_baz ",
@@ -69,6 +79,14 @@ a paragraph with an index term.`
 						},
 					},
 				},
+				Index: &types.Index{
+					Entries: []*types.IndexEntry{
+						{
+							Term:    "foo_bar_baz italic normal",
+							Anchors: []string{"_indexterm_1"},
+						},
+					},
+				},
 			}
 			Expect(ParseDocument(source)).To(MatchDocument(expected))
 		})
@@ -89,6 +107,7 @@ var _ = Describe("concealed index terms", func() {
 								Content: "a paragraph with an index term ",
 							},
 							&types.ConcealedIndexTerm{
+								ID:    "_indexterm_1",
 								Term1: "index",
 								Term2: "term",
 								Term3: "here",
@@ -99,6 +118,24 @@ var _ = Describe("concealed index terms", func() {
 						},
 					},
 				},
+				Index: &types.Index{
+					Entries: []*types.IndexEntry{
+						{
+							Term: "index",
+							Entries: []*types.IndexEntry{
+								{
+									Term: "term",
+									Entries: []*types.IndexEntry{
+										{
+											Term:    "here",
+											Anchors: []string{"_indexterm_1"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 			}
 			Expect(ParseDocument(source)).To(MatchDocument(expected))
 		})
@@ -111,6 +148,7 @@ a paragraph with an index term.`
 					&types.Paragraph{
 						Elements: []interface{}{
 							&types.ConcealedIndexTerm{
+								ID:    "_indexterm_1",
 								Term1: "index",
 								Term2: "term",
 							},
@@ -120,8 +158,116 @@ a paragraph with an index term.`
 						},
 					},
 				},
+				Index: &types.Index{
+					Entries: []*types.IndexEntry{
+						{
+							Term: "index",
+							Entries: []*types.IndexEntry{
+								{
+									Term:    "term",
+									Anchors: []string{"_indexterm_1"},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+	})
+})
+
+var _ = Describe("index term macros", func() {
+
+	Context("in final documents", func() {
+
+		It("visible and concealed index term macros", func() {
+			source := `indexterm2:[Galahad] indexterm:[Knights, Round Table, Galahad]sat at the table.`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Paragraph{
+						Elements: []interface{}{
+							&types.IndexTerm{
+								ID: "_indexterm_1",
+								Term: []interface{}{
+									&types.StringElement{
+										Content: "Galahad",
+									},
+								},
+							},
+							&types.StringElement{
+								Content: " ",
+							},
+							&types.ConcealedIndexTerm{
+								ID:    "_indexterm_2",
+								Term1: "Knights",
+								Term2: "Round Table",
+								Term3: "Galahad",
+							},
+							&types.StringElement{
+								Content: "sat at the table.",
+							},
+						},
+					},
+				},
+				Index: &types.Index{
+					Entries: []*types.IndexEntry{
+						{
+							Term:    "Galahad",
+							Anchors: []string{"_indexterm_1"},
+						},
+						{
+							Term: "Knights",
+							Entries: []*types.IndexEntry{
+								{
+									Term: "Round Table",
+									Entries: []*types.IndexEntry{
+										{
+											Term:    "Galahad",
+											Anchors: []string{"_indexterm_2"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 			}
 			Expect(ParseDocument(source)).To(MatchDocument(expected))
 		})
 	})
 })
+
+var _ = Describe("index", func() {
+
+	It("with merged and sorted entries", func() {
+		source := `((Lancelot)) and ((apple))
+
+(((Knights, Lancelot))) and ((Lancelot)) and (((Knights)))`
+		expected := &types.Index{
+			Entries: []*types.IndexEntry{
+				{
+					Term:    "apple",
+					Anchors: []string{"_indexterm_2"},
+				},
+				{
+					Term:    "Knights",
+					Anchors: []string{"_indexterm_5"},
+					Entries: []*types.IndexEntry{
+						{
+							Term:    "Lancelot",
+							Anchors: []string{"_indexterm_3"},
+						},
+					},
+				},
+				{
+					Term:    "Lancelot",
+					Anchors: []string{"_indexterm_1", "_indexterm_4"},
+				},
+			},
+		}
+		doc, err := ParseDocument(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Index).To(Equal(expected))
+	})
+})
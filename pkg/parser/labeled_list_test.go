@@ -177,6 +177,7 @@ on 2 lines`
 								Style: types.DoubleColons,
 								Term: []interface{}{
 									&types.IndexTerm{
+										ID: "_indexterm_1",
 										Term: []interface{}{
 											&types.QuotedText{
 												Kind: types.SingleQuoteMonospace,
@@ -213,6 +214,14 @@ on 2 lines`
 						},
 					},
 				},
+				Index: &types.Index{
+					Entries: []*types.IndexEntry{
+						{
+							Term:    "foo",
+							Anchors: []string{"_indexterm_1"},
+						},
+					},
+				},
 			}
 			Expect(ParseDocument(source)).To(MatchDocument(expected))
 		})
@@ -229,6 +238,7 @@ on 2 lines`
 								Style: types.DoubleColons,
 								Term: []interface{}{
 									&types.ConcealedIndexTerm{
+										ID:    "_indexterm_1",
 										Term1: "foo",
 										Term2: "bar",
 									},
@@ -257,6 +267,19 @@ on 2 lines`
 						},
 					},
 				},
+				Index: &types.Index{
+					Entries: []*types.IndexEntry{
+						{
+							Term: "foo",
+							Entries: []*types.IndexEntry{
+								{
+									Term:    "bar",
+									Anchors: []string{"_indexterm_1"},
+								},
+							},
+						},
+					},
+				},
 			}
 			Expect(ParseDocument(source)).To(MatchDocument(expected))
 		})
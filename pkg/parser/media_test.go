@@ -0,0 +1,124 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("videos and audios", func() {
+
+	Context("in final documents", func() {
+
+		It("video without attributes", func() {
+			source := "video::screencast.mp4[]"
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.VideoBlock{
+						Location: &types.Location{
+							Path: "screencast.mp4",
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("video with positional and named attributes", func() {
+			source := `.A screencast
+[#intro]
+video::screencast.mp4[poster.png,640,480,start=10,end=20,opts="autoplay,loop"]`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.VideoBlock{
+						Attributes: types.Attributes{
+							types.AttrID:          "intro",
+							types.AttrTitle:       "A screencast",
+							types.AttrMediaPoster: "poster.png",
+							types.AttrWidth:       "640",
+							types.AttrHeight:      "480",
+							types.AttrMediaStart:  "10",
+							types.AttrMediaEnd:    "20",
+							types.AttrOptions:     types.Options{"autoplay", "loop"},
+						},
+						Location: &types.Location{
+							Path: "screencast.mp4",
+						},
+					},
+				},
+				ElementReferences: types.ElementReferences{
+					"intro": "A screencast",
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("video hosted on youtube", func() {
+			source := "video::rPQoq7ThGAU[youtube]"
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.VideoBlock{
+						Attributes: types.Attributes{
+							types.AttrMediaPoster: "youtube",
+						},
+						Location: &types.Location{
+							Path: "rPQoq7ThGAU",
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("audio with options", func() {
+			source := `audio::podcast.mp3[options="autoplay,nocontrols"]`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.AudioBlock{
+						Attributes: types.Attributes{
+							types.AttrOptions: types.Options{"autoplay", "nocontrols"},
+						},
+						Location: &types.Location{
+							Path: "podcast.mp3",
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("audio in list element continuation", func() {
+			source := `* episode 1
++
+audio::episode1.mp3[]`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.List{
+						Kind: types.UnorderedListKind,
+						Elements: []types.ListElement{
+							&types.UnorderedListElement{
+								BulletStyle: types.OneAsterisk,
+								CheckStyle:  types.NoCheck,
+								Elements: []interface{}{
+									&types.Paragraph{
+										Elements: []interface{}{
+											&types.StringElement{Content: "episode 1"},
+										},
+									},
+									&types.AudioBlock{
+										Location: &types.Location{
+											Path: "episode1.mp3",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -152,7 +153,7 @@ func (c *current) isInlineMacro(text string) bool {
 	default:
 		switch text {
 		// TODO: refine by checking is associated substitution is enabled?
-		case "http", "https", "mailto", "image", "link", "icon", "pass", "menu", "xref", "btn":
+		case "http", "https", "mailto", "image", "link", "icon", "pass", "stem", "latexmath", "asciimath", "cite", "citenp", "indexterm", "indexterm2", "menu", "xref", "btn":
 			log.Debugf("invalid inline text: '%s' (conflicts with inline macro)", text)
 			return true
 		}
@@ -248,9 +249,127 @@ func (c *current) matchBlockDelimiterLength(length int) (bool, error) {
 	return c.globalStore[blockDelimiterLengthKey] == length, nil
 }
 
+const tableDelimiterKey = "table_delimiter"
+
+const tableFormatKey = "table_format"
+
+const tableSeparatorKey = "table_separator"
+
+// state info to indicate the delimiter of the current table, along with its data format and cell separator,
+// which depend on the delimiter (eg: `,===` for CSV) unless the `format` and `separator` attributes are set
+func (c *current) setTableDelimiter(delimiter string) (bool, error) {
+	attrs, _ := c.globalStore[blockAttributesKey].(types.Attributes)
+	// consume the block attributes, so that they do not apply to a subsequent table without attributes
+	c.storeBlockAttributes(nil)
+	format := attrs.GetAsStringWithDefault(types.AttrTableFormat, "")
+	switch format {
+	case types.PrefixSeparatedValues, types.CommaSeparatedValues, types.TabSeparatedValues, types.DelimiterSeparatedValues:
+	case "":
+		format = types.DefaultTableFormat(delimiter)
+	default:
+		log.Warnf("unsupported table format: '%s'", format)
+		format = types.DefaultTableFormat(delimiter)
+	}
+	separator := attrs.GetAsStringWithDefault(types.AttrTableSeparator, "")
+	switch separator {
+	case "":
+		separator = types.DefaultTableSeparator(format, delimiter)
+	case `\t`:
+		separator = "\t"
+	}
+	if err := validateTableSeparator(format, separator); err != nil {
+		// fall back to the default separator of the format
+		c.warnf(types.InvalidTableSeparator, "%s", err.Error())
+		separator = types.DefaultTableSeparator(format, delimiter)
+	}
+	c.globalStore[tableDelimiterKey] = delimiter
+	c.globalStore[tableFormatKey] = format
+	c.globalStore[tableSeparatorKey] = separator
+	return true, nil
+}
+
+// validateTableSeparator returns an error if the given separator cannot be used to split the cells of a table
+// in the given data format (eg: a newline, a double quote in CSV, or a multi-character separator in PSV, CSV or TSV)
+func validateTableSeparator(format, separator string) error {
+	if strings.ContainsAny(separator, "\r\n") {
+		return fmt.Errorf("invalid table cell separator '%s': a separator cannot contain a newline", separator)
+	}
+	if format == types.DelimiterSeparatedValues {
+		return nil
+	}
+	if utf8.RuneCountInString(separator) != 1 {
+		return fmt.Errorf("invalid table cell separator '%s': the '%s' format only supports single-character separators", separator, format)
+	}
+	if r, _ := utf8.DecodeRuneInString(separator); r == utf8.RuneError || (r == '"' && format != types.PrefixSeparatedValues) {
+		return fmt.Errorf("invalid table cell separator '%s' for the '%s' format", separator, format)
+	}
+	return nil
+}
+
+// check if the given delimiter matches the delimiter of the current table
+func (c *current) matchTableDelimiter(delimiter string) (bool, error) {
+	return c.globalStore[tableDelimiterKey] == delimiter, nil
+}
+
+// check if the cells of the current table are in the prefix-separated values format (eg: `| cell`)
+func (c *current) isPrefixSeparatedValuesTable() bool {
+	format, found := c.globalStore[tableFormatKey].(string)
+	return !found || format == types.PrefixSeparatedValues
+}
+
+// check if the given character is the cell separator of the current table
+func (c *current) isTableCellSeparator(s string) bool {
+	separator, found := c.globalStore[tableSeparatorKey].(string)
+	if !found {
+		return s == "|"
+	}
+	return s == separator
+}
+
+// newTable returns a new table with the given lines, which are split into cells
+// if the table uses the CSV, TSV or DSV data format
+func (c *current) newTable(lines []interface{}) (*types.Table, error) {
+	format, _ := c.globalStore[tableFormatKey].(string)
+	separator, _ := c.globalStore[tableSeparatorKey].(string)
+	switch format {
+	case types.CommaSeparatedValues, types.TabSeparatedValues, types.DelimiterSeparatedValues:
+		return types.NewDelimiterSeparatedValuesTable(lines, format, separator)
+	default:
+		return types.NewTable(lines)
+	}
+}
+
+// diagnosticsKey the key in which the diagnostics are stored in the parser's GlobalStore
+const diagnosticsKey = "diagnostics"
+
+// filenameKey the key in which the name of the document is stored in the parser's GlobalStore
+const filenameKey = "filename"
+
+// warnf logs a warning and reports it in the diagnostics, at the position of the last match
+func (c *current) warnf(code types.DiagnosticCode, format string, args ...interface{}) {
+	log.Warnf(format, args...)
+	diagnostics, _ := c.globalStore[diagnosticsKey].(*types.Diagnostics)
+	diagnostics.Warnf(code, c.sourcePosition(), format, args...)
+}
+
 const usermacrosKey = "user_macros"
 
+// macroProcessorsKey the key in which the macro processors are stored in the parser's GlobalStore
+const macroProcessorsKey = "macro_processors"
+
+// diagramsKey the key in which the kinds of diagrams are stored in the parser's GlobalStore
+// (to parse the diagram block macros, eg: `plantuml::sequence.puml[]`)
+const diagramsKey = "diagrams"
+
 func (c *current) hasUserMacro(name string) bool {
+	if processors, ok := c.globalStore[macroProcessorsKey].(map[string]configuration.MacroProcessor); ok {
+		if _, found := processors[name]; found {
+			return true
+		}
+	}
+	if diagrams, ok := c.globalStore[diagramsKey].(map[string]bool); ok && diagrams[name] {
+		return true
+	}
 	if macros, ok := c.globalStore[usermacrosKey].(map[string]configuration.MacroTemplate); ok {
 		_, found := macros[name]
 		// log.Debugf("user macro '%s' registered: %t", name, found)
@@ -0,0 +1,183 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("stem", func() {
+
+	Context("in final documents", func() {
+
+		Context("inline macros", func() {
+
+			It("with stem, latexmath and asciimath macros", func() {
+				source := `stem:[x < 1], latexmath:[\frac{a}{b}] and asciimath:[sqrt(4) = 2]`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.InlineStem{
+									Kind:    types.Stem,
+									Content: "x < 1",
+								},
+								&types.StringElement{
+									Content: ", ",
+								},
+								&types.InlineStem{
+									Kind:    types.LatexMath,
+									Content: `\frac{a}{b}`,
+								},
+								&types.StringElement{
+									Content: " and ",
+								},
+								&types.InlineStem{
+									Kind:    types.AsciiMath,
+									Content: "sqrt(4) = 2",
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("with escaped closing bracket", func() {
+				source := `stem:[[a,b\]]`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.InlineStem{
+									Kind:    types.Stem,
+									Content: "[a,b]",
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("with backslashes only", func() {
+				source := `latexmath:[\\] and stem:[\]`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.InlineStem{
+									Kind:    types.LatexMath,
+									Content: `\\`,
+								},
+								&types.StringElement{
+									Content: " and ",
+								},
+								&types.InlineStem{
+									Kind:    types.Stem,
+									Content: `\`,
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("with escaped closing bracket and backslashes", func() {
+				source := `latexmath:[a\\]b] and latexmath:[\\]`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.InlineStem{
+									Kind:    types.LatexMath,
+									Content: `a\]b`,
+								},
+								&types.StringElement{
+									Content: " and ",
+								},
+								&types.InlineStem{
+									Kind:    types.LatexMath,
+									Content: `\\`,
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("without substitutions in content", func() {
+				source := `:foo: bar
+
+asciimath:[{foo} *x*]`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.AttributeDeclaration{
+							Name:  "foo",
+							Value: "bar",
+						},
+						&types.Paragraph{
+							Elements: []interface{}{
+								&types.InlineStem{
+									Kind:    types.AsciiMath,
+									Content: "{foo} *x*",
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+		})
+
+		Context("blocks", func() {
+
+			It("as delimited block", func() {
+				source := `[stem]
+++++
+sqrt(4) = 2
+++++`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.DelimitedBlock{
+							Kind: types.Passthrough,
+							Attributes: types.Attributes{
+								types.AttrStyle: types.Stem,
+							},
+							Elements: []interface{}{
+								&types.StringElement{
+									Content: "sqrt(4) = 2",
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("as paragraph without substitutions", func() {
+				source := `[latexmath]
+a *b* c`
+				expected := &types.Document{
+					Elements: []interface{}{
+						&types.Paragraph{
+							Attributes: types.Attributes{
+								types.AttrStyle: types.LatexMath,
+							},
+							Elements: []interface{}{
+								&types.StringElement{
+									Content: "a *b* c",
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+		})
+	})
+})
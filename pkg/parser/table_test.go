@@ -1,8 +1,10 @@
 package parser_test
 
 import (
+	"path/filepath"
 	"strings"
 
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
@@ -1649,6 +1651,1071 @@ image::another-image.png[]
 			}
 			Expect(ParseDocument(source)).To(MatchDocument(expected))
 		})
+
+		It("with comma-separated values", func() {
+			source := `,===
+name,description
+
+foo,"a value, with ""quotes"""
+bar,"a value
+on 2 lines"
+,===`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Header: &types.TableRow{
+							Cells: []*types.TableCell{
+								{
+									Elements: []interface{}{
+										&types.Paragraph{
+											Elements: []interface{}{
+												&types.StringElement{
+													Content: "name",
+												},
+											},
+										},
+									},
+								},
+								{
+									Elements: []interface{}{
+										&types.Paragraph{
+											Elements: []interface{}{
+												&types.StringElement{
+													Content: "description",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "foo",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: `a value, with "quotes"`,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "bar",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "a value\non 2 lines",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with delimiter-separated values", func() {
+			source := `:===
+foo:bar\:baz
+a:b
+:===`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "foo",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "bar:baz",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "a",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "b",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with tab-separated values", func() {
+			source := `[format=tsv]
+|===
+foo	bar
+a	b
+|===`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Attributes: types.Attributes{
+							types.AttrTableFormat: "tsv",
+						},
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "foo",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "bar",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "a",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "b",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with comma-separated values and custom separator", func() {
+			source := `[format=csv,separator=;]
+|===
+foo;"bar;baz"
+|===`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Attributes: types.Attributes{
+							types.AttrTableFormat:    "csv",
+							types.AttrTableSeparator: ";",
+						},
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "foo",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "bar;baz",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with prefix-separated values and custom separator", func() {
+			source := `[separator=¦]
+|===
+¦foo ¦bar|baz
+¦a ¦b
+|===`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Attributes: types.Attributes{
+							types.AttrTableSeparator: "¦",
+						},
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "foo",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "bar|baz",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "a",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "b",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with comma-separated values and invalid separator", func() {
+			source := `[format=csv,separator='"']
+|===
+foo,bar
+|===
+
+after`
+			filename, err := filepath.Abs("test.adoc")
+			Expect(err).NotTo(HaveOccurred())
+			diagnostics := types.NewDiagnostics()
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Attributes: types.Attributes{
+							types.AttrTableFormat:    "csv",
+							types.AttrTableSeparator: `"`,
+						},
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "foo",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "bar",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&types.Paragraph{
+						Elements: []interface{}{
+							&types.StringElement{
+								Content: "after",
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source, configuration.WithDiagnostics(diagnostics))).To(MatchDocument(expected))
+			Expect(diagnostics.All()).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.InvalidTableSeparator,
+					Message:  `invalid table cell separator '"' for the 'csv' format`,
+					Position: &types.SourcePosition{File: filename, Line: 2, Column: 1},
+				},
+			}))
+		})
+
+		It("with prefix-separated values and multi-character separator", func() {
+			source := `[separator=!!]
+|===
+|foo !!bar
+|===`
+			filename, err := filepath.Abs("test.adoc")
+			Expect(err).NotTo(HaveOccurred())
+			diagnostics := types.NewDiagnostics()
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Attributes: types.Attributes{
+							types.AttrTableSeparator: "!!",
+						},
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "foo !!bar",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source, configuration.WithDiagnostics(diagnostics))).To(MatchDocument(expected))
+			Expect(diagnostics.All()).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.InvalidTableSeparator,
+					Message:  "invalid table cell separator '!!': the 'psv' format only supports single-character separators",
+					Position: &types.SourcePosition{File: filename, Line: 2, Column: 1},
+				},
+			}))
+		})
+
+		It("with prefix-separated values after a table with comma-separated values", func() {
+			source := `[format=csv]
+|===
+foo,bar
+|===
+
+|===
+|a,b
+|===`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Attributes: types.Attributes{
+							types.AttrTableFormat: "csv",
+						},
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "foo",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "bar",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&types.Table{
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "a,b",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with comma-separated values in included file", func() {
+			source := `,===
+include::../../test/includes/table_data.csv[]
+,===`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Header: &types.TableRow{
+							Cells: []*types.TableCell{
+								{
+									Elements: []interface{}{
+										&types.Paragraph{
+											Elements: []interface{}{
+												&types.StringElement{
+													Content: "name",
+												},
+											},
+										},
+									},
+								},
+								{
+									Elements: []interface{}{
+										&types.Paragraph{
+											Elements: []interface{}{
+												&types.StringElement{
+													Content: "value",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "foo",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "1",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "bar",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "2, or 3",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with cells spanning columns and rows", func() {
+			source := `|===
+2+| a | b
+.2+| c | d | e
+| f | g
+|===`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Attributes: types.Attributes{
+											types.AttrColSpan: 2,
+										},
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "a",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "b",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Cells: []*types.TableCell{
+									{
+										Attributes: types.Attributes{
+											types.AttrRowSpan: 2,
+										},
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "c",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "d",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "e",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "f",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "g",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with cell spanning columns and rows", func() {
+			source := `|===
+2.2+| a | b
+| c
+| d | e | f
+|===`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Attributes: types.Attributes{
+											types.AttrColSpan: 2,
+											types.AttrRowSpan: 2,
+										},
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "a",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "b",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "c",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "d",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "e",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "f",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with duplicated cells, alignments and styles", func() {
+			source := `|===
+2*^e| a | b
+.>s| c m| d >.^| e
+|===`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Format: "e",
+										Attributes: types.Attributes{
+											types.AttrHAlign: types.HAlignCenter,
+										},
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "a",
+													},
+												},
+											},
+										},
+									},
+									{
+										Format: "e",
+										Attributes: types.Attributes{
+											types.AttrHAlign: types.HAlignCenter,
+										},
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "a",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "b",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Cells: []*types.TableCell{
+									{
+										Format: "s",
+										Attributes: types.Attributes{
+											types.AttrVAlign: types.VAlignBottom,
+										},
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "c",
+													},
+												},
+											},
+										},
+									},
+									{
+										Format: "m",
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "d",
+													},
+												},
+											},
+										},
+									},
+									{
+										Attributes: types.Attributes{
+											types.AttrHAlign: types.HAlignRight,
+											types.AttrVAlign: types.VAlignMiddle,
+										},
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "e",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with literal cell", func() {
+			source := `|===
+l| some   *literal*
+content | other
+|===`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Format: "l",
+										Elements: []interface{}{
+											&types.DelimitedBlock{
+												Kind: types.Literal,
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "some   *literal*\ncontent",
+													},
+												},
+											},
+										},
+									},
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "other",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("with nested table in AsciiDoc cell", func() {
+			source := `|===
+| outer a| nested table:
+
+!===
+! nested 1 ! nested 2
+!===
+|===`
+			expected := &types.Document{
+				Elements: []interface{}{
+					&types.Table{
+						Rows: []*types.TableRow{
+							{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "outer",
+													},
+												},
+											},
+										},
+									},
+									{
+										Format: "a",
+										Elements: []interface{}{
+											&types.Paragraph{
+												Elements: []interface{}{
+													&types.StringElement{
+														Content: "nested table:",
+													},
+												},
+											},
+											&types.Table{
+												Rows: []*types.TableRow{
+													{
+														Cells: []*types.TableCell{
+															{
+																Elements: []interface{}{
+																	&types.Paragraph{
+																		Elements: []interface{}{
+																			&types.StringElement{
+																				Content: "nested 1",
+																			},
+																		},
+																	},
+																},
+															},
+															{
+																Elements: []interface{}{
+																	&types.Paragraph{
+																		Elements: []interface{}{
+																			&types.StringElement{
+																				Content: "nested 2",
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
 	})
 })
 
@@ -5,7 +5,9 @@ import (
 	"io"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml/docbook5"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml/manpage"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml/xhtml5"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 )
@@ -16,6 +18,10 @@ func Render(doc *types.Document, config *configuration.Configuration, output io.
 		return html5.Render(doc, config, output)
 	case "xhtml", "xhtml5":
 		return xhtml5.Render(doc, config, output)
+	case "docbook", "docbook5":
+		return docbook5.Render(doc, config, output)
+	case "manpage":
+		return manpage.Render(doc, config, output)
 	default:
 		return types.Metadata{}, fmt.Errorf("backend '%s' not supported", config.BackEnd)
 	}
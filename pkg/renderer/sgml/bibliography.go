@@ -0,0 +1,308 @@
+package sgml
+
+import (
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/bibtex"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// citations are rendered with the number of the entry in the bibliography (eg: `[1]`)
+	numericBibliographyStyle string = "numeric"
+	// citations are rendered with the authors and year of the entry (eg: `(Lane, 2000)`)
+	authorYearBibliographyStyle string = "author-year"
+	// entries of the bibliography are sorted by author and year, instead of their order of appearance
+	alphabeticalBibliographyOrder string = "alphabetical"
+)
+
+// bibliography the entries of the BibTeX file of the document, along with the entries which are cited
+type bibliography struct {
+	style   string
+	entries map[string]*bibtex.Entry
+	cited   []*bibtex.Entry // the cited entries, in the order of the bibliography
+	numbers map[string]int  // the number of each cited entry in the bibliography
+}
+
+// loads the BibTeX file set in the `bibtex-file` attribute (if any), and collects the cited entries of the document
+func (r *sgmlRenderer) prerenderBibliography(ctx *context, elements []interface{}) {
+	file := ctx.attributes.GetAsStringWithDefault(types.AttrBibTeXFile, "")
+	if file == "" {
+		return
+	}
+	b := &bibliography{
+		style:   numericBibliographyStyle,
+		entries: map[string]*bibtex.Entry{},
+		numbers: map[string]int{},
+	}
+	if ctx.attributes.GetAsStringWithDefault(types.AttrBibTeXStyle, numericBibliographyStyle) == authorYearBibliographyStyle {
+		b.style = authorYearBibliographyStyle
+	}
+	ctx.bibliography = b
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(baseDir(ctx), file)
+	}
+	if !canRead(ctx, file) {
+		return
+	}
+	entries, err := bibtex.ReadFile(file)
+	if err != nil {
+		log.Warnf("unable to load bibliography: %v", err)
+		ctx.config.Diagnostics.Warnf(types.UnresolvedBibliography, nil, "unable to load bibliography: %v", err)
+		return
+	}
+	for _, e := range entries {
+		b.entries[e.Key] = e
+	}
+	for _, key := range citationKeys(elements) {
+		if e, found := b.entries[key]; found {
+			b.cited = append(b.cited, e)
+		}
+	}
+	if ctx.attributes.GetAsStringWithDefault(types.AttrBibTeXOrder, "") == alphabeticalBibliographyOrder {
+		sort.SliceStable(b.cited, func(i, j int) bool {
+			ai, aj := sortableAuthor(b.cited[i]), sortableAuthor(b.cited[j])
+			if ai != aj {
+				return ai < aj
+			}
+			return b.cited[i].Year() < b.cited[j].Year()
+		})
+	}
+	for i, e := range b.cited {
+		b.numbers[e.Key] = i + 1
+	}
+}
+
+// returns the keys of the entries cited in the given elements, in order of appearance
+func citationKeys(elements []interface{}) []string {
+	keys := []string{}
+	seen := map[string]bool{}
+	collect := types.VisitorFuncs{
+		EnterFunc: func(c *types.Cursor) error {
+			if e, ok := c.Element().(*types.InlineCitation); ok {
+				for _, ref := range e.References {
+					if !seen[ref.Key] {
+						seen[ref.Key] = true
+						keys = append(keys, ref.Key)
+					}
+				}
+			}
+			return nil
+		},
+	}
+	for _, e := range elements {
+		if err := types.Walk(e, collect); err != nil {
+			log.Warnf("unable to collect citations: %v", err)
+		}
+	}
+	return keys
+}
+
+func sortableAuthor(e *bibtex.Entry) string {
+	if authors := e.Authors(); len(authors) > 0 {
+		return strings.ToLower(bibtex.LastName(authors[0]))
+	}
+	return strings.ToLower(e.Title())
+}
+
+func (r *sgmlRenderer) renderInlineCitation(ctx *context, c *types.InlineCitation) (string, error) {
+	b := ctx.bibliography
+	refs := make([]string, 0, len(c.References))
+	for _, ref := range c.References {
+		var entry *bibtex.Entry
+		if b != nil {
+			entry = b.entries[ref.Key]
+		}
+		if entry == nil {
+			log.Warnf("invalid citation: %s", ref.Key)
+			ctx.config.Diagnostics.Warnf(types.UnresolvedCitation, ctx.position, "invalid citation: %s", ref.Key)
+			refs = append(refs, "["+escapeString(ref.Key)+"]")
+			continue
+		}
+		var label string
+		switch {
+		case b.style == authorYearBibliographyStyle && c.Kind == types.NonParentheticalCitation:
+			label = citationAuthors(entry) + " (" + citationYear(entry) + withLocator(ref.Locator) + ")"
+		case b.style == authorYearBibliographyStyle:
+			label = citationAuthors(entry) + ", " + citationYear(entry) + withLocator(ref.Locator)
+		default:
+			label = strconv.Itoa(b.numbers[entry.Key]) + withLocator(ref.Locator)
+		}
+		ref, err := r.execute(r.internalCrossReference, struct {
+			Href  string
+			Label string
+		}{
+			Href:  bibliographyEntryID(entry.Key),
+			Label: escapeString(label),
+		})
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render citation")
+		}
+		refs = append(refs, ref)
+	}
+	var content string
+	switch {
+	case b != nil && b.style == authorYearBibliographyStyle && c.Kind == types.NonParentheticalCitation:
+		content = strings.Join(refs, "; ")
+	case b != nil && b.style == authorYearBibliographyStyle:
+		content = "(" + strings.Join(refs, "; ") + ")"
+	case c.Kind == types.NonParentheticalCitation:
+		content = strings.Join(refs, ", ")
+	default:
+		content = "[" + strings.Join(refs, ", ") + "]"
+	}
+	return r.execute(r.inlineCitation, struct {
+		Kind    string
+		Content string
+	}{
+		Kind:    c.Kind,
+		Content: content,
+	})
+}
+
+func withLocator(locator string) string {
+	if locator == "" {
+		return ""
+	}
+	return ", " + locator
+}
+
+// returns the last name of the single author, of both authors, or of the first author followed by `et al.`
+func citationAuthors(e *bibtex.Entry) string {
+	authors := e.Authors()
+	switch len(authors) {
+	case 0:
+		return e.Title()
+	case 1:
+		return bibtex.LastName(authors[0])
+	case 2:
+		return bibtex.LastName(authors[0]) + " and " + bibtex.LastName(authors[1])
+	default:
+		return bibtex.LastName(authors[0]) + " et al."
+	}
+}
+
+func citationYear(e *bibtex.Entry) string {
+	if year := e.Year(); year != "" {
+		return year
+	}
+	return "n.d."
+}
+
+func bibliographyEntryID(key string) string {
+	return "_bib_" + key
+}
+
+func (r *sgmlRenderer) renderBibliographyAnchor(a *types.BibliographyAnchor) (string, error) {
+	return r.execute(r.bibliographyAnchor, struct {
+		ID    string
+		Label string
+	}{
+		ID:    a.ID,
+		Label: escapeString(a.Label),
+	})
+}
+
+type bibliographyEntry struct {
+	ID      string
+	Label   string
+	Content string
+}
+
+func (r *sgmlRenderer) renderBibliographyBlock(ctx *context, b *types.BibliographyBlock) (string, error) {
+	if ctx.bibliography == nil {
+		log.Warn("no bibliography to render: the 'bibtex-file' attribute is not set")
+		ctx.config.Diagnostics.Warnf(types.UnresolvedBibliography, ctx.position, "no bibliography to render: the '%s' attribute is not set", types.AttrBibTeXFile)
+		return "", nil
+	}
+	entries := make([]*bibliographyEntry, len(ctx.bibliography.cited))
+	for i, e := range ctx.bibliography.cited {
+		entries[i] = &bibliographyEntry{
+			ID:      bibliographyEntryID(e.Key),
+			Content: escapeString(ctx.bibliography.entryContent(e)),
+		}
+		if ctx.bibliography.style == numericBibliographyStyle {
+			entries[i].Label = "[" + strconv.Itoa(i+1) + "]"
+		}
+	}
+	roles, err := r.renderElementRoles(ctx, b.Attributes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render bibliography roles")
+	}
+	title, err := r.renderElementTitle(ctx, b.Attributes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render bibliography title")
+	}
+	return r.execute(r.bibliographyBlock, struct {
+		Context *context
+		ID      string
+		Title   string
+		Roles   string
+		Entries []*bibliographyEntry
+	}{
+		Context: ctx,
+		ID:      r.renderElementID(b.Attributes),
+		Title:   title,
+		Roles:   roles,
+		Entries: entries,
+	})
+}
+
+// returns the text of the given entry in the bibliography:
+// `Authors. Title. Container, Year.` in the numeric style, or `Authors (Year). Title. Container.` in the author-year style,
+// where the (optional) container is the journal, the book or the publisher of the entry.
+func (b *bibliography) entryContent(e *bibtex.Entry) string {
+	container := []string{}
+	for _, f := range []string{"journal", "booktitle", "publisher"} {
+		if v := e.Fields[f]; v != "" {
+			container = append(container, v)
+		}
+	}
+	parts := []string{}
+	authors := joinAuthors(e.Authors())
+	if b.style == authorYearBibliographyStyle {
+		if authors != "" {
+			parts = append(parts, authors+" ("+citationYear(e)+")")
+		} else {
+			parts = append(parts, "("+citationYear(e)+")")
+		}
+		if title := e.Title(); title != "" {
+			parts = append(parts, title)
+		}
+		if len(container) > 0 {
+			parts = append(parts, strings.Join(container, ", "))
+		}
+	} else {
+		if authors != "" {
+			parts = append(parts, authors)
+		}
+		if title := e.Title(); title != "" {
+			parts = append(parts, title)
+		}
+		if year := e.Year(); year != "" {
+			container = append(container, year)
+		}
+		if len(container) > 0 {
+			parts = append(parts, strings.Join(container, ", "))
+		}
+	}
+	return strings.Join(parts, ". ") + "."
+}
+
+// joins the given authors with commas, and `and` before the last one
+func joinAuthors(authors []string) string {
+	switch len(authors) {
+	case 0:
+		return ""
+	case 1:
+		return authors[0]
+	default:
+		return strings.Join(authors[:len(authors)-1], ", ") + " and " + authors[len(authors)-1]
+	}
+}
@@ -16,6 +16,13 @@ type context struct {
 	elementReferences    types.ElementReferences
 	hasHeader            bool
 	sectionNumbering     types.SectionNumbers
+	sectionCaptions      map[string]string
+	footnotes            []*types.Footnote
+	bibliography         *bibliography // entries of the BibTeX file, if any
+	withinBibliography   bool          // within a `[bibliography]` section
+	index                *types.Index
+	hasIndexSection      bool                  // if `true`, then the anchors of the index terms are rendered
+	position             *types.SourcePosition // position of the block element being rendered, if known
 }
 
 // newContext returns a new rendering context for the given document.
@@ -24,15 +31,25 @@ func newContext(doc *types.Document, config *configuration.Configuration) *conte
 	ctx := &context{
 		config:            config,
 		counters:          make(map[string]int),
-		attributes:        config.Attributes,
+		attributes:        documentAttributes(config),
 		elementReferences: doc.ElementReferences,
 		hasHeader:         header != nil,
+		footnotes:         doc.Footnotes,
+		index:             doc.Index,
+		hasIndexSection:   hasIndexSection(doc.Elements),
 	}
 	// TODO: add other attributes from https://docs.asciidoctor.org/asciidoc/latest/attributes/document-attributes-ref/#builtin-attributes-i18n
 	ctx.attributes[types.AttrFigureCaption] = "Figure"
 	ctx.attributes[types.AttrExampleCaption] = "Example"
 	ctx.attributes[types.AttrTableCaption] = "Table"
 	ctx.attributes[types.AttrVersionLabel] = "version"
+	if !ctx.attributes.Has(types.AttrAppendixCaption) {
+		ctx.attributes[types.AttrAppendixCaption] = "Appendix"
+	}
+	// the default stylesheet is used unless the `stylesheet` attribute is unset
+	if !ctx.attributes.Has(types.AttrStylesheet) && !config.UnsetAttributes[types.AttrStylesheet] {
+		ctx.attributes[types.AttrStylesheet] = ""
+	}
 	// also, expand authors and revision
 	if header != nil {
 		if authors := header.Authors(); authors != nil {
@@ -47,10 +64,52 @@ func newContext(doc *types.Document, config *configuration.Configuration) *conte
 	return ctx
 }
 
+// documentAttributes returns the initial attributes of the document, ie, the attributes set and soft-set with the CLI or the API
+func documentAttributes(config *configuration.Configuration) types.Attributes {
+	attrs := config.SoftAttributes.Clone()
+	for k, v := range config.Attributes {
+		attrs[k] = v
+	}
+	return attrs
+}
+
+// setAttribute sets the attribute declared in the document, unless it was set or unset with the CLI or the API (ie, it is locked)
+func (ctx *context) setAttribute(name string, value interface{}) {
+	if ctx.isLocked(name) {
+		return
+	}
+	ctx.attributes[name] = value
+}
+
+// unsetAttribute unsets the attribute reset in the document, unless it was set with the CLI or the API (ie, it is locked)
+func (ctx *context) unsetAttribute(name string) {
+	if ctx.isLocked(name) {
+		return
+	}
+	delete(ctx.attributes, name)
+}
+
+// isLocked returns true if the attribute was set or unset with the CLI or the API, or if it cannot be set in the document
+// in the current safe mode
+func (ctx *context) isLocked(name string) bool {
+	_, set := ctx.config.Attributes[name]
+	return set || ctx.config.UnsetAttributes[name] || ctx.config.SafeMode.IsLocked(name)
+}
+
 func (ctx *context) UseUnicode() bool {
 	return ctx.attributes.GetAsBoolWithDefault(types.AttrUnicode, true)
 }
 
+// footnote returns the footnote with the given ID, or nil if none was found
+func (ctx *context) footnote(id int) *types.Footnote {
+	for _, note := range ctx.footnotes {
+		if note.ID == id {
+			return note
+		}
+	}
+	return nil
+}
+
 const tableCounter = "tableCounter"
 
 // GetAndIncrementTableCounter returns the current value for the table counter after internally incrementing it.
@@ -72,6 +131,18 @@ func (ctx *context) GetAndIncrementExampleBlockCounter() int {
 	return ctx.getAndIncrementCounter(exampleBlockCounter)
 }
 
+const calloutListCounter = "calloutListCounter"
+
+// GetAndIncrementCalloutListCounter returns the current value for the callout list counter after internally incrementing it.
+func (ctx *context) GetAndIncrementCalloutListCounter() int {
+	return ctx.getAndIncrementCounter(calloutListCounter)
+}
+
+// GetCalloutListCounter returns the current value for the callout list counter, without incrementing it.
+func (ctx *context) GetCalloutListCounter() int {
+	return ctx.counters[calloutListCounter]
+}
+
 // getAndIncrementCounter returns the current value for the  counter after internally incrementing it.
 func (ctx *context) getAndIncrementCounter(name string) int {
 	if _, found := ctx.counters[name]; !found {
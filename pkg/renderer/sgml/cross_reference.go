@@ -15,39 +15,26 @@ func (r *sgmlRenderer) renderInternalCrossReference(ctx *context, xref *types.In
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debugf("rendering cross reference with ID: %s", spew.Sdump(xref.ID))
 	}
-	var label string
 	xrefID, ok := xref.ID.(string)
 	if !ok {
 		return "", errors.Errorf("unable to process internal cross reference: invalid ID: '%v'", xref.ID)
 	}
+	// `<<other.adoc#section>>` is a reference to another document
+	if path, fragment := splitCrossReference(xrefID); isAsciiDocFile(path) {
+		return r.renderInterDocumentReference(ctx, path, fragment, xref.Label)
+	}
+	target, found := ctx.elementReferences[xrefID]
+	if !found {
+		log.Warnf("invalid reference: %s", xrefID)
+		ctx.config.Diagnostics.Warnf(types.UnresolvedCrossReference, ctx.position, "invalid reference: %s", xrefID)
+	}
+	var label string
 	if xrefLabel, ok := xref.Label.(string); ok {
 		label = xrefLabel
-	} else if target, found := ctx.elementReferences[xrefID]; found {
-		switch t := target.(type) {
-		case string:
-			label = t
-		case []interface{}:
-			// render as usual except for links as plain text (since the cross reference is already displayed as a link)
-			buff := &strings.Builder{}
-			for _, e := range t {
-				switch e := e.(type) {
-				case *types.InlineLink:
-					renderedElement, err := RenderPlainText(e)
-					if err != nil {
-						return "", err
-					}
-					buff.WriteString(renderedElement)
-				default:
-					renderedElement, err := r.renderElement(ctx, e)
-					if err != nil {
-						return "", err
-					}
-					buff.WriteString(renderedElement)
-				}
-			}
-			label = buff.String()
-		default:
-			return "", errors.Errorf("unable to process internal cross reference to element of type %T", target)
+	} else if found {
+		var err error
+		if label, err = r.renderCrossReferenceTarget(ctx, target); err != nil {
+			return "", err
 		}
 	} else {
 		label = "[" + xrefID + "]"
@@ -61,8 +48,42 @@ func (r *sgmlRenderer) renderInternalCrossReference(ctx *context, xref *types.In
 	})
 }
 
+// renders the title of the element (or document) targeted by a cross reference
+func (r *sgmlRenderer) renderCrossReferenceTarget(ctx *context, target interface{}) (string, error) {
+	switch t := target.(type) {
+	case string:
+		return t, nil
+	case []interface{}:
+		// render as usual except for links as plain text (since the cross reference is already displayed as a link)
+		buff := &strings.Builder{}
+		for _, e := range t {
+			switch e := e.(type) {
+			case *types.InlineLink:
+				renderedElement, err := RenderPlainText(e)
+				if err != nil {
+					return "", err
+				}
+				buff.WriteString(renderedElement)
+			default:
+				renderedElement, err := r.renderElement(ctx, e)
+				if err != nil {
+					return "", err
+				}
+				buff.WriteString(renderedElement)
+			}
+		}
+		return buff.String(), nil
+	default:
+		return "", errors.Errorf("unable to process internal cross reference to element of type %T", target)
+	}
+}
+
 func (r *sgmlRenderer) renderExternalCrossReference(ctx *context, xref *types.ExternalCrossReference) (string, error) {
 	// log.Debugf("rendering cross reference with ID: %s", xref.Location)
+	loc := xref.Location.ToDisplayString()
+	if path, fragment := splitCrossReference(loc); filepath.Ext(path) != "" {
+		return r.renderInterDocumentReference(ctx, path, fragment, xref.Attributes[types.AttrXRefLabel])
+	}
 	var label string
 	var err error
 	switch l := xref.Attributes[types.AttrXRefLabel].(type) {
@@ -73,31 +94,117 @@ func (r *sgmlRenderer) renderExternalCrossReference(ctx *context, xref *types.Ex
 			return "", err
 		}
 	default:
-		label = defaultXrefLabel(xref)
+		label = "[" + loc + "]" // internal references are within brackets
 	}
 	return r.execute(r.externalCrossReference, struct {
 		Href  string
 		Label string
 	}{
-		Href:  getCrossReferenceLocation(xref),
+		Href:  "#" + loc,
 		Label: label,
 	})
 }
 
-func defaultXrefLabel(xref *types.ExternalCrossReference) string {
-	loc := xref.Location.ToDisplayString()
-	ext := filepath.Ext(loc)
-	if ext == "" {
-		return "[" + loc + "]" // internal references are within brackets
+// renders a cross reference to another document (or to an element in another document), with a default label
+// which is the title of the targeted element (or document) when it is in the catalog of the configuration,
+// or the path to the other document otherwise.
+func (r *sgmlRenderer) renderInterDocumentReference(ctx *context, path, fragment string, label interface{}) (string, error) {
+	var entry *types.CatalogEntry
+	found := false
+	if ctx.config.Catalog != nil {
+		entry, found = ctx.config.Catalog.Lookup(filepath.Join(filepath.Dir(ctx.config.Filename), path))
+	}
+	href := ctx.attributes.GetAsStringWithDefault(types.AttrRelFilePrefix, "") + outputLocation(ctx, path, entry)
+	defaultLabel := href
+	var target interface{}
+	if fragment != "" && samePath(filepath.Join(filepath.Dir(ctx.config.Filename), path), ctx.config.Filename) {
+		// reference to an element in the current document
+		href = ""
+		defaultLabel = "[" + fragment + "]"
+		if t, found := ctx.elementReferences[fragment]; found {
+			target = t
+		} else {
+			log.Warnf("invalid reference: %s", fragment)
+			ctx.config.Diagnostics.Warnf(types.UnresolvedCrossReference, ctx.position, "invalid reference: %s", fragment)
+		}
+	} else if ctx.config.Catalog != nil {
+		switch {
+		case !found:
+			log.Warnf("invalid reference to document: %s", path)
+			ctx.config.Diagnostics.Warnf(types.UnresolvedCrossReference, ctx.position, "invalid reference to document: %s", path)
+		case fragment != "":
+			if t, found := entry.ElementReferences[fragment]; found {
+				target = t
+			} else {
+				log.Warnf("invalid reference: %s#%s", path, fragment)
+				ctx.config.Diagnostics.Warnf(types.UnresolvedCrossReference, ctx.position, "invalid reference: %s#%s", path, fragment)
+			}
+		case len(entry.Title) > 0:
+			target = entry.Title
+		}
 	}
-	return loc[:len(loc)-len(ext)] + ".html"
+	if fragment != "" {
+		href += "#" + fragment
+	}
+	var result string
+	var err error
+	switch l := label.(type) {
+	case string:
+		result = l
+	case []interface{}:
+		result, err = r.renderInlineElements(ctx, l)
+	default:
+		if target != nil {
+			result, err = r.renderCrossReferenceTarget(ctx, target)
+		} else {
+			result = defaultLabel
+		}
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render cross reference label")
+	}
+	return r.execute(r.externalCrossReference, struct {
+		Href  string
+		Label string
+	}{
+		Href:  href,
+		Label: result,
+	})
 }
 
-func getCrossReferenceLocation(xref *types.ExternalCrossReference) string {
-	loc := xref.Location.ToDisplayString()
-	ext := filepath.Ext(loc)
-	if ext == "" { // internal reference
-		return "#" + loc
+// returns the location of the output file of the document at the given path: the path to its output file
+// relative to the output directory of the current document, if both are known, or the path to the document
+// with the `outfilesuffix` extension otherwise
+func outputLocation(ctx *context, path string, entry *types.CatalogEntry) string {
+	if entry != nil && entry.OutputPath != "" && ctx.config.OutputDir != "" {
+		if rel, err := filepath.Rel(ctx.config.OutputDir, entry.OutputPath); err == nil {
+			return filepath.ToSlash(rel)
+		}
+	}
+	return strings.TrimSuffix(path, filepath.Ext(path)) + ctx.attributes.GetAsStringWithDefault(types.AttrOutFileSuffix, ".html")
+}
+
+// splits the given reference into a path and an (optional) fragment (eg: `other.adoc#section`)
+func splitCrossReference(ref string) (string, string) {
+	if i := strings.Index(ref, "#"); i >= 0 {
+		return ref[:i], ref[i+1:]
 	}
-	return loc[:len(loc)-len(ext)] + ".html" // TODO output extension
+	return ref, ""
+}
+
+// returns `true` if the given path has the extension of an AsciiDoc file
+func isAsciiDocFile(path string) bool {
+	switch filepath.Ext(path) {
+	case ".adoc", ".asciidoc", ".asc", ".ad":
+		return true
+	default:
+		return false
+	}
+}
+
+// returns `true` if both paths refer to the same file
+func samePath(p1, p2 string) bool {
+	a1, err1 := filepath.Abs(p1)
+	a2, err2 := filepath.Abs(p2)
+	return err1 == nil && err2 == nil && a1 == a2
 }
@@ -22,6 +22,10 @@ func (r *sgmlRenderer) renderDelimitedBlock(ctx *context, b *types.DelimitedBloc
 	case types.MarkdownQuote:
 		return r.renderMarkdownQuoteBlock(ctx, b)
 	case types.Passthrough:
+		switch b.Attributes.GetAsStringWithDefault(types.AttrStyle, "") {
+		case types.Stem, types.LatexMath, types.AsciiMath:
+			return r.renderStemBlock(ctx, b)
+		}
 		return r.renderPassthroughBlock(ctx, b)
 	case types.Quote:
 		return r.renderQuoteBlock(ctx, b)
@@ -75,7 +75,9 @@ func (r *sgmlRenderer) renderSourceBlockElements(ctx *context, b *types.Delimite
 	language := b.Attributes.GetAsStringWithDefault(types.AttrLanguage, "")
 
 	// render without syntax highlight
-	if language == "" || (highlighter != "chroma" && highlighter != "pygments") {
+	// (also when the backend is not HTML-based, since the highlighter produces HTML markup)
+	if language == "" || (highlighter != "chroma" && highlighter != "pygments") ||
+		ctx.attributes.GetAsStringWithDefault(types.AttrBaseBackEnd, "html") != "html" {
 		log.Debug("rendering souce block without syntax highlighting")
 		content, err := r.renderElements(ctx, b.Elements)
 		return content, highlighter, language, err
@@ -129,7 +131,7 @@ func (r *sgmlRenderer) renderSourceBlockElements(ctx *context, b *types.Delimite
 		result.WriteString(highlightedLineBuf.String())
 		// append callouts at the end of the highlighted line
 		for _, callout := range callouts {
-			renderedCallout, err := r.renderCalloutRef(callout)
+			renderedCallout, err := r.renderCalloutRef(ctx, callout)
 			if err != nil {
 				return "", "", "", err
 			}
@@ -170,14 +172,21 @@ func (r *sgmlRenderer) renderSourceLine(_ *context, line interface{}) (string, [
 	return result.String(), callouts, nil
 }
 
-func (r *sgmlRenderer) renderCalloutRef(co *types.Callout) (string, error) {
+func (r *sgmlRenderer) renderCalloutRef(ctx *context, co *types.Callout) (string, error) {
 	result := &strings.Builder{}
 
 	tmpl, err := r.calloutRef()
 	if err != nil {
 		return "", errors.Wrap(err, "unable to load cross references template")
 	}
-	if err = tmpl.Execute(result, co); err != nil {
+	if err = tmpl.Execute(result, struct {
+		Ref        int
+		ListNumber int
+	}{
+		Ref: co.Ref,
+		// callouts are always followed by their list, which will have the next number
+		ListNumber: ctx.GetCalloutListCounter() + 1,
+	}); err != nil {
 		return "", errors.Wrap(err, "unable to render callout reference")
 	}
 	return result.String(), nil
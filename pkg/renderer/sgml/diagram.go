@@ -0,0 +1,164 @@
+package sgml
+
+import (
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// the default format of the images generated for the diagrams
+const defaultDiagramFormat = "svg"
+
+// renders the image of the given diagram, which is generated in the `imagesoutdir` directory unless the image
+// of the same source was already generated, or renders the source of the diagram in a listing block if the image
+// cannot be generated (or the text of the block macro if its source file cannot be read)
+func (r *sgmlRenderer) renderDiagramBlock(ctx *context, d *types.DiagramBlock) (string, error) {
+	source, err := diagramSource(ctx, d)
+	if err != nil {
+		log.Warn(err.Error())
+		ctx.config.Diagnostics.Warnf(types.FailedDiagram, d.SourcePosition, "%s", err.Error())
+		return r.renderDiagramSource(ctx, d, d.Kind+"::"+d.Location.ToString()+"[]")
+	}
+	format := d.Attributes.GetAsStringWithDefault(types.AttrImageFormat, defaultDiagramFormat)
+	checksum := diagramChecksum(d.Kind, format, source)
+	target, hasTarget := d.Attributes.GetAsString(types.AttrDiagramTarget)
+	if !hasTarget {
+		target = "diag-" + checksum
+	}
+	filename := target + "." + format
+	path, err := diagramPath(ctx, filename)
+	if err != nil {
+		log.Warn(err.Error())
+		ctx.config.Diagnostics.Warnf(types.RestrictedAccess, d.SourcePosition, "%s", err.Error())
+		return r.renderDiagramSource(ctx, d, source)
+	}
+	if err := generateDiagram(ctx, d.Kind, source, format, path, checksum); err != nil {
+		log.Warn(err.Error())
+		ctx.config.Diagnostics.Warnf(types.FailedDiagram, d.SourcePosition, "%s", err.Error())
+		return r.renderDiagramSource(ctx, d, source)
+	}
+	attrs := d.Attributes.Clone()
+	attrs.Unset(types.AttrDiagramTarget)
+	if !hasTarget && !attrs.Has(types.AttrImageAlt) {
+		attrs = attrs.Set(types.AttrImageAlt, "Diagram")
+	}
+	return r.renderImageBlock(ctx, &types.ImageBlock{
+		Location: &types.Location{
+			Path: filename,
+		},
+		Attributes:     attrs,
+		SourcePosition: d.SourcePosition,
+	})
+}
+
+// renders the source of the given diagram in a listing block (when its image cannot be generated)
+func (r *sgmlRenderer) renderDiagramSource(ctx *context, d *types.DiagramBlock, source string) (string, error) {
+	return r.renderDelimitedBlock(ctx, &types.DelimitedBlock{
+		Kind:       types.Listing,
+		Attributes: d.Attributes,
+		Elements: []interface{}{
+			&types.StringElement{
+				Content: escapeString(source),
+			},
+		},
+	})
+}
+
+// returns the path to the image of a diagram with the given filename (ie, its target and format) in the `imagesoutdir`,
+// or an error if the filename contains a path separator, or if the image would be outside of the base directory
+// in the safe modes
+func diagramPath(ctx *context, filename string) (string, error) {
+	if strings.ContainsAny(filename, `/\`) {
+		return "", errors.Errorf("access to '%s' is restricted: the target and format of a diagram cannot contain a path separator", filename)
+	}
+	path := filepath.Join(imagesOutDir(ctx), filename)
+	if err := ctx.config.SafeMode.CheckAccess(ctx.config.JailDir(), path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// returns the source of the given diagram, which may be in a separate file (eg: `plantuml::sequence.puml[]`)
+func diagramSource(ctx *context, d *types.DiagramBlock) (string, error) {
+	if d.Location == nil {
+		return d.Source, nil
+	}
+	path := localPath(ctx, d.Location.ToString())
+	if !canRead(ctx, path) {
+		return "", errors.Errorf("unable to read the source of the diagram: %s", path)
+	}
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to read the source of the diagram")
+	}
+	return string(source), nil
+}
+
+// returns the checksum of the source of a diagram, which is used to name the generated image (when the `target`
+// attribute is not set), and to know if the image needs to be generated again
+func diagramChecksum(kind, format, source string) string {
+	h := sha1.New() //nolint:gosec
+	h.Write([]byte(kind + "\n" + format + "\n" + source))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// returns the directory in which the images of the diagrams are generated, ie, the `imagesoutdir` attribute if set,
+// or the `imagesdir` in the output directory otherwise. An absolute `imagesoutdir` is ignored in the safe modes.
+func imagesOutDir(ctx *context) string {
+	if dir, found := ctx.attributes.GetAsString(types.AttrImagesOutDir); found {
+		switch {
+		case !filepath.IsAbs(dir):
+			return filepath.Join(baseDir(ctx), dir)
+		case ctx.config.SafeMode < configuration.Safe:
+			return dir
+		default:
+			log.Warnf("ignoring the absolute '%s' attribute in the '%s' safe mode", types.AttrImagesOutDir, ctx.config.SafeMode)
+		}
+	}
+	dir := ctx.config.OutputDir
+	if dir == "" {
+		dir = baseDir(ctx)
+	}
+	if imagesdir := ctx.attributes.GetAsStringWithDefault(types.AttrImagesDir, ""); imagesdir != "" && !isRemote(imagesdir) {
+		return filepath.Join(dir, filepath.FromSlash(imagesdir))
+	}
+	return dir
+}
+
+// generates the image of the diagram in the given file, unless it was already generated from the same source.
+// The checksum of the source is written in a hidden `.cache` file next to the image.
+func generateDiagram(ctx *context, kind, source, format, path, checksum string) error {
+	cache := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".cache")
+	if c, err := os.ReadFile(cache); err == nil && strings.TrimSpace(string(c)) == checksum {
+		if _, err := os.Stat(path); err == nil {
+			log.Debugf("using cached image of diagram: %s", path)
+			return nil
+		}
+	}
+	convert, found := ctx.config.DiagramConverters[kind]
+	if !found {
+		return errors.Errorf("unable to convert diagram: no converter registered for the '%s' diagrams", kind)
+	}
+	data, err := convert(source, format)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrap(err, "unable to write the image of the diagram")
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil { //nolint:gosec
+		return errors.Wrap(err, "unable to write the image of the diagram")
+	}
+	if err := os.WriteFile(cache, []byte(checksum+"\n"), 0644); err != nil { //nolint:gosec
+		log.Warnf("unable to write the checksum of the diagram: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package docbook5
+
+const (
+	bibliographyAnchorTmpl = `<anchor xml:id="{{ .ID }}" xreflabel="[{{ .Label }}]"/>[{{ .Label }}]`
+
+	inlineCitationTmpl = "<citation>{{ .Content }}</citation>"
+
+	bibliographyBlockTmpl = `<bibliodiv` +
+		`{{ if .ID }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		">\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"{{ range .Entries }}<bibliomixed>\n" +
+		"<bibliomisc><anchor xml:id=\"{{ .ID }}\"{{ if .Label }} xreflabel=\"{{ .Label }}\"{{ end }}/>{{ if .Label }}{{ .Label }} {{ end }}{{ .Content }}</bibliomisc>\n" +
+		"</bibliomixed>\n{{ end }}" +
+		"</bibliodiv>\n"
+)
@@ -0,0 +1,5 @@
+package docbook5
+
+const (
+	lineBreakTmpl = "<?asciidoc-br?>"
+)
@@ -0,0 +1,16 @@
+package docbook5
+
+const (
+	calloutListTmpl = `<calloutlist` +
+		`{{ if .ID }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		">\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"{{ .Content }}" +
+		"</calloutlist>\n"
+
+	// NB: the callouts in a block are bound to the callout list which follows the block.
+	calloutListElementTmpl = "<callout arearefs=\"CO{{ .ListNumber }}-{{ .Ref }}\">\n{{ .Content }}</callout>\n"
+
+	calloutRefTmpl = `<co xml:id="CO{{ .ListNumber }}-{{ .Ref }}"/>`
+)
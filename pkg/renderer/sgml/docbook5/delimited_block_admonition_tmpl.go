@@ -0,0 +1,12 @@
+package docbook5
+
+const (
+	// the admonition kind (`note`, `tip`, etc.) matches the name of the DocBook element
+	admonitionBlockTmpl = `<{{ .Kind }}` +
+		`{{ if .ID }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		">\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"{{ .Content }}" +
+		"</{{ .Kind }}>\n"
+)
@@ -0,0 +1,11 @@
+package docbook5
+
+const (
+	exampleBlockTmpl = `{{ if .Title }}<example{{ else }}<informalexample{{ end }}` +
+		`{{ if .ID }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		">\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"{{ .Content }}" +
+		"{{ if .Title }}</example>{{ else }}</informalexample>{{ end }}\n"
+)
@@ -0,0 +1,13 @@
+package docbook5
+
+const (
+	fencedBlockTmpl = "{{ if .Title }}<formalpara{{ if .ID }} xml:id=\"{{ .ID }}\"{{ end }}>\n" +
+		"<title>{{ .Title }}</title>\n" +
+		"<para>\n{{ end }}" +
+		`<programlisting` +
+		`{{ if and .ID (not .Title) }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		` linenumbering="unnumbered">` +
+		"{{ .Content }}</programlisting>\n" +
+		"{{ if .Title }}</para>\n</formalpara>\n{{ end }}"
+)
@@ -0,0 +1,13 @@
+package docbook5
+
+const (
+	listingBlockTmpl = "{{ if .Title }}<formalpara{{ if .ID }} xml:id=\"{{ .ID }}\"{{ end }}>\n" +
+		"<title>{{ .Title }}</title>\n" +
+		"<para>\n{{ end }}" +
+		`<screen` +
+		`{{ if and .ID (not .Title) }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		`>` +
+		"{{ .Content }}</screen>\n" +
+		"{{ if .Title }}</para>\n</formalpara>\n{{ end }}"
+)
@@ -0,0 +1,13 @@
+package docbook5
+
+const (
+	literalBlockTmpl = "{{ if .Title }}<formalpara{{ if .ID }} xml:id=\"{{ .ID }}\"{{ end }}>\n" +
+		"<title>{{ .Title }}</title>\n" +
+		"<para>\n{{ end }}" +
+		`<literallayout` +
+		`{{ if and .ID (not .Title) }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		` class="monospaced">` +
+		"{{ .Content }}</literallayout>\n" +
+		"{{ if .Title }}</para>\n</formalpara>\n{{ end }}"
+)
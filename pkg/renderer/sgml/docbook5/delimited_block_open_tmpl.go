@@ -0,0 +1,6 @@
+package docbook5
+
+const (
+	// open blocks have no equivalent in DocBook, so only their content is rendered
+	openBlockTmpl = "{{ .Content }}"
+)
@@ -0,0 +1,11 @@
+package docbook5
+
+const (
+	sidebarBlockTmpl = `<sidebar` +
+		`{{ if .ID }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		">\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"{{ .Content }}" +
+		"</sidebar>\n"
+)
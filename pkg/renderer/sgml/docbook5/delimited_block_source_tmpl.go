@@ -0,0 +1,14 @@
+package docbook5
+
+const (
+	sourceBlockTmpl = "{{ if .Title }}<formalpara{{ if .ID }} xml:id=\"{{ .ID }}\"{{ end }}>\n" +
+		"<title>{{ .Title }}</title>\n" +
+		"<para>\n{{ end }}" +
+		`<programlisting` +
+		`{{ if and .ID (not .Title) }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		`{{ if .Language }} language="{{ .Language }}"{{ end }}` +
+		` linenumbering="unnumbered">` +
+		"{{ .Content }}</programlisting>\n" +
+		"{{ if .Title }}</para>\n</formalpara>\n{{ end }}"
+)
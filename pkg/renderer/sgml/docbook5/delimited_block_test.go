@@ -0,0 +1,106 @@
+package docbook5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("delimited blocks", func() {
+
+	It("source block with callouts", func() {
+		source := `[source,go]
+----
+func main() { // <1>
+}
+----
+<1> the main func`
+		expected := `<programlisting language="go" linenumbering="unnumbered">func main() { // <co xml:id="CO1-1"/>
+}</programlisting>
+<calloutlist>
+<callout arearefs="CO1-1">
+<simpara>the main func</simpara>
+</callout>
+</calloutlist>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("listing block with title", func() {
+		source := `.a title
+----
+some <code>
+----`
+		expected := `<formalpara>
+<title>a title</title>
+<para>
+<screen>some &lt;code&gt;</screen>
+</para>
+</formalpara>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("example block", func() {
+		source := `.a title
+====
+some content
+====`
+		expected := `<example>
+<title>a title</title>
+<simpara>some content</simpara>
+</example>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("quote block", func() {
+		source := `[quote, John Doe, Quote Title]
+____
+some content
+____`
+		expected := `<blockquote>
+<attribution>
+John Doe
+<citetitle>Quote Title</citetitle>
+</attribution>
+<simpara>some content</simpara>
+</blockquote>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("sidebar block", func() {
+		source := `****
+some content
+****`
+		expected := `<sidebar>
+<simpara>some content</simpara>
+</sidebar>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("stem block with title", func() {
+		source := `.a title
+[stem]
+++++
+x < 1
+++++`
+		expected := `<equation>
+<title>a title</title>
+<alt>x &lt; 1</alt>
+<math xmlns="http://www.w3.org/1998/Math/MathML" display="block"><mrow><mi>x</mi><mo>&lt;</mo><mn>1</mn></mrow></math>
+</equation>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("inline stem", func() {
+		source := `latexmath:[x^2]`
+		expected := `<simpara><inlineequation><alt>x^2</alt><math xmlns="http://www.w3.org/1998/Math/MathML"><msup><mi>x</mi><mn>2</mn></msup></math></inlineequation></simpara>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+})
@@ -0,0 +1,15 @@
+package docbook5
+
+const (
+	verseBlockTmpl = `<blockquote` +
+		`{{ if .ID }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		">\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"{{ if .Attribution.First }}<attribution>\n" +
+		"{{ .Attribution.First }}\n" +
+		"{{ if .Attribution.Second }}<citetitle>{{ .Attribution.Second }}</citetitle>\n{{ end }}" +
+		"</attribution>\n{{ end }}" +
+		"<literallayout>{{ .Content }}</literallayout>\n" +
+		"</blockquote>\n"
+)
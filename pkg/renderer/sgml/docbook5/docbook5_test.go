@@ -0,0 +1,76 @@
+package docbook5_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("document header", func() {
+
+	It("header with authors and revision", func() {
+		source := `= The _Document_ Title
+John Doe <john@example.com>
+v1.0, 2021-01-01: first draft
+
+a paragraph`
+		expected := `<?xml version="1.0" encoding="UTF-8"?>
+<article xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="en">
+<info>
+<title>The <emphasis>Document</emphasis> Title</title>
+<date>2021-01-01</date>
+<author>
+<personname>John Doe</personname>
+<email>john@example.com</email>
+</author>
+<revhistory>
+<revision>
+<revnumber>1.0</revnumber>
+<date>2021-01-01</date>
+<revremark>first draft</revremark>
+</revision>
+</revhistory>
+</info>
+<simpara>a paragraph</simpara>
+</article>
+`
+		Expect(RenderDocBook(source, configuration.WithHeaderFooter(true))).To(MatchHTML(expected))
+	})
+
+	It("header with role and id", func() {
+		source := `[#doc.my_role]
+= My Title`
+		expected := `<?xml version="1.0" encoding="UTF-8"?>
+<article xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="en" xml:id="doc" role="my_role">
+<info>
+<title>My Title</title>
+</info>
+</article>
+`
+		Expect(RenderDocBook(source, configuration.WithHeaderFooter(true))).To(MatchHTML(expected))
+	})
+
+	It("book doctype", func() {
+		source := `= My Book
+:doctype: book`
+		expected := `<?xml version="1.0" encoding="UTF-8"?>
+<book xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="en">
+<info>
+<title>My Book</title>
+</info>
+</book>
+`
+		Expect(RenderDocBook(source, configuration.WithHeaderFooter(true))).To(MatchHTML(expected))
+	})
+
+	It("header without header/footer", func() {
+		source := `= My Title
+
+a paragraph`
+		expected := `<simpara>a paragraph</simpara>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+})
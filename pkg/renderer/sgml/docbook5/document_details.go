@@ -0,0 +1,20 @@
+package docbook5
+
+const (
+	// NB: in DocBook, a revision requires a date
+	documentDetailsTmpl = `{{ if .RevDate }}<date>{{ .RevDate }}</date>
+{{ end }}{{ if .Authors }}{{ .Authors }}
+{{ end }}{{ if .RevDate }}<revhistory>
+<revision>
+{{ if .RevNumber }}<revnumber>{{ .RevNumber }}</revnumber>
+{{ end }}<date>{{ .RevDate }}</date>
+{{ if .RevRemark }}<revremark>{{ .RevRemark }}</revremark>
+{{ end }}</revision>
+</revhistory>
+{{ end }}`
+
+	documentAuthorDetailsTmpl = `{{ if .Name }}<author>
+<personname>{{ .Name }}</personname>
+{{ if .Email }}<email>{{ .Email }}</email>
+{{ end }}</author>{{ end }}`
+)
@@ -0,0 +1,12 @@
+package docbook5
+
+const (
+	// footnotes are rendered inline in DocBook
+	footnoteTmpl        = `<footnote xml:id="_footnotedef_{{ .ID }}"><simpara>{{ .Content }}</simpara></footnote>`
+	footnoteRefTmpl     = `<footnoteref linkend="_footnotedef_{{ .ID }}"/>`
+	invalidFootnoteTmpl = `[{{ .Ref }}]`
+
+	// ... hence, nothing is rendered at the end of the document
+	footnotesTmpl       = "{{- /* footnotes are rendered inline */ -}}"
+	footnoteElementTmpl = "{{- /* footnotes are rendered inline */ -}}"
+)
@@ -0,0 +1,31 @@
+package docbook5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("footnotes and index terms", func() {
+
+	It("footnotes are rendered inline", func() {
+		source := `a note.footnote:[the *note*] and a ref.footnote:ref[a ref] and again.footnote:ref[]`
+		expected := `<simpara>a note.<footnote xml:id="_footnotedef_1"><simpara>the <emphasis role="strong">note</emphasis></simpara></footnote> and a ref.<footnote xml:id="_footnotedef_2"><simpara>a ref</simpara></footnote> and again.<footnoteref linkend="_footnotedef_2"/></simpara>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("index terms", func() {
+		source := `a ((visible)) term and a concealed one(((foo, bar, baz)))`
+		expected := `<simpara>a <indexterm>
+<primary>visible</primary>
+</indexterm>visible term and a concealed one<indexterm>
+<primary>foo</primary>
+<secondary>bar</secondary>
+<tertiary>baz</tertiary>
+</indexterm></simpara>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+})
@@ -0,0 +1,24 @@
+package docbook5
+
+const (
+	inlineIconTmpl = `{{ if .Link }}<link xl:href="{{ .Link }}">{{ end }}` +
+		`{{ .Icon }}` +
+		`{{ if .Link }}</link>{{ end }}`
+
+	iconImageTmpl = `<inlinemediaobject>` +
+		`<imageobject>` +
+		`<imagedata fileref="{{ .Src }}"` +
+		`{{ if .Width }} contentwidth="{{ .Width }}"{{ end }}` +
+		`{{ if .Height }} contentdepth="{{ .Height }}"{{ end }}/>` +
+		`</imageobject>` +
+		`{{ if .Alt }}<textobject><phrase>{{ .Alt }}</phrase></textobject>{{ end }}` +
+		`</inlinemediaobject>`
+
+	// font icons are not supported in DocBook, so we fall back to the text
+	iconFontTmpl = `[{{ if .Title }}{{ .Title }}{{ else }}{{ .Class }}{{ end }}]`
+
+	// SVG icons are not supported in DocBook either
+	iconSVGTmpl = iconFontTmpl
+
+	iconTextTmpl = `[{{ .Alt }}]`
+)
@@ -0,0 +1,20 @@
+package docbook5
+
+const (
+	blockImageTmpl = `{{ if .Title }}<figure{{ else }}<informalfigure{{ end }}{{ if .ID }} xml:id="{{ .ID }}"{{ end }}{{ if .Roles }} role="{{ .Roles }}"{{ end }}>
+{{ if .Title }}<title>{{ .Title }}</title>
+{{ end }}<mediaobject>
+<imageobject>
+<imagedata fileref="{{ .Src }}"{{ if .Width }} contentwidth="{{ .Width }}"{{ end }}{{ if .Height }} contentdepth="{{ .Height }}"{{ end }}/>
+</imageobject>
+<textobject><phrase>{{ .Alt }}</phrase></textobject>
+</mediaobject>
+{{ if .Title }}</figure>{{ else }}</informalfigure>{{ end }}
+`
+	inlineImageTmpl = `<inlinemediaobject{{ if .Roles }} role="{{ .Roles }}"{{ end }}>` +
+		`<imageobject>` +
+		`<imagedata fileref="{{ .Src }}"{{ if .Width }} contentwidth="{{ .Width }}"{{ end }}{{ if .Height }} contentdepth="{{ .Height }}"{{ end }}/>` +
+		`</imageobject>` +
+		`<textobject><phrase>{{ .Alt }}</phrase></textobject>` +
+		`</inlinemediaobject>`
+)
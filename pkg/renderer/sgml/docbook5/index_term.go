@@ -0,0 +1,15 @@
+package docbook5
+
+const (
+	indexTermTmpl = "<indexterm>\n<primary>{{ .Content }}</primary>\n</indexterm>{{ .Content }}"
+
+	concealedIndexTermTmpl = "<indexterm>\n" +
+		"<primary>{{ .Term1 }}</primary>\n" +
+		"{{ if .Term2 }}<secondary>{{ .Term2 }}</secondary>\n{{ end }}" +
+		"{{ if .Term3 }}<tertiary>{{ .Term3 }}</tertiary>\n{{ end }}" +
+		"</indexterm>"
+
+	// the index is generated by the DocBook toolchain, from the `indexterm` elements
+	indexTmpl      = "{{- /* index */ -}}"
+	indexEntryTmpl = "{{- /* index entry */ -}}"
+)
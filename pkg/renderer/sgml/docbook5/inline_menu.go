@@ -0,0 +1,20 @@
+package docbook5
+
+const (
+	inlineMenuTmpl =
+	// eg: `<guimenu>File</guimenu>`
+	`{{ if len .Path | eq 1 }}<guimenu>{{ index .Path 0 }}</guimenu>` +
+		// eg: `<menuchoice><guimenu>File</guimenu> <guisubmenu>Zoom</guisubmenu> <guimenuitem>Reset</guimenuitem></menuchoice>`
+		`{{ else }}` +
+		`<menuchoice>` +
+		`{{ with $path := .Path }}` +
+		`{{ range $index, $element := $path }}` +
+		`{{ if eq $index 0 }}<guimenu>{{ $element }}</guimenu>` +
+		`{{ else if lastInStrings $path $index }} <guimenuitem>{{ $element }}</guimenuitem>` +
+		`{{ else }} <guisubmenu>{{ $element }}</guisubmenu>` +
+		`{{ end }}` +
+		`{{ end }}` +
+		`</menuchoice>` +
+		`{{ end }}` +
+		`{{ end }}`
+)
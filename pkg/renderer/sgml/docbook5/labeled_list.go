@@ -0,0 +1,31 @@
+package docbook5
+
+const (
+	labeledListTmpl = `<variablelist` +
+		`{{ if .ID }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		">\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"{{ .Content }}</variablelist>\n"
+
+	// Continuation items (multiple terms sharing a single definition) are grouped in the same entry.
+	labeledListElementTmpl = "{{ if not .Continuation }}<varlistentry>\n{{ end }}" +
+		"<term>{{ .Term }}</term>\n" +
+		"{{ if .Content }}<listitem>\n{{ .Content }}</listitem>\n</varlistentry>\n{{ end }}"
+
+	// there is no horizontal layout in DocBook
+	labeledListHorizontalTmpl        = labeledListTmpl
+	labeledListHorizontalElementTmpl = labeledListElementTmpl
+
+	qAndAListTmpl = `<qandaset` +
+		`{{ if .ID }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		">\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"{{ .Content }}</qandaset>\n"
+
+	qAndAListElementTmpl = "<qandaentry>\n" +
+		"<question>\n<simpara>{{ .Term }}</simpara>\n</question>\n" +
+		"<answer>\n{{ .Content }}</answer>\n" +
+		"</qandaentry>\n"
+)
@@ -0,0 +1,5 @@
+package docbook5
+
+const (
+	linkTmpl = `<link{{ if .ID }} xml:id="{{ .ID }}"{{ end }} xl:href="{{ .URL }}"{{ if .Class }} role="{{ .Class }}"{{ end }}{{ if .Target }} xl:show="new"{{ end }}>{{ .Text }}</link>`
+)
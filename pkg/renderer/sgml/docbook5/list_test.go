@@ -0,0 +1,67 @@
+package docbook5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lists", func() {
+
+	It("unordered list", func() {
+		source := `* item 1
+* item 2`
+		expected := `<itemizedlist>
+<listitem>
+<simpara>item 1</simpara>
+</listitem>
+<listitem>
+<simpara>item 2</simpara>
+</listitem>
+</itemizedlist>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("ordered list with title and start", func() {
+		source := `.a title
+[start=3]
+. item 1
+. item 2`
+		expected := `<orderedlist numeration="arabic" startingnumber="3">
+<title>a title</title>
+<listitem>
+<simpara>item 1</simpara>
+</listitem>
+<listitem>
+<simpara>item 2</simpara>
+</listitem>
+</orderedlist>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("labeled list", func() {
+		source := `term 1:: definition 1
+term 2::
+term 3:: definition 2`
+		expected := `<variablelist>
+<varlistentry>
+<term>term 1</term>
+<listitem>
+<simpara>definition 1</simpara>
+</listitem>
+</varlistentry>
+<varlistentry>
+<term>term 2</term>
+<term>term 3</term>
+<listitem>
+<simpara>definition 2</simpara>
+</listitem>
+</varlistentry>
+</variablelist>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+})
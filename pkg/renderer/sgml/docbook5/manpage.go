@@ -0,0 +1,11 @@
+package docbook5
+
+const (
+	manpageHeaderTmpl = `{{ if .IncludeH1 }}<info>
+<title>{{ .Header }} Manual Page</title>
+</info>
+{{ end }}<section xml:id="_name">
+<title>{{ .Name }}</title>
+{{ .Content }}</section>
+`
+)
@@ -0,0 +1,14 @@
+package docbook5
+
+const (
+	orderedListTmpl = `<orderedlist` +
+		`{{ if .ID }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		` numeration="{{ .Style }}"` +
+		`{{ if .Start }} startingnumber="{{ .Start }}"{{ end }}` +
+		">\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"{{ .Content }}</orderedlist>\n"
+
+	orderedListElementTmpl = "<listitem>\n{{ .Content }}</listitem>\n"
+)
@@ -0,0 +1,45 @@
+package docbook5
+
+const (
+	paragraphTmpl = "{{ if .Title }}<formalpara{{ else }}<simpara{{ end }}" +
+		"{{ if .ID }} xml:id=\"{{ .ID }}\"{{ end }}" +
+		"{{ if .Roles }} role=\"{{ .Roles }}\"{{ end }}>" +
+		"{{ if .Title }}\n<title>{{ .Title }}</title>\n<para>{{ .Content }}</para>\n</formalpara>" +
+		"{{ else }}{{ .Content }}</simpara>{{ end }}\n"
+
+	admonitionParagraphTmpl = `{{ if .Content }}` +
+		"<{{ .Kind }}" +
+		"{{ if .ID }} xml:id=\"{{ .ID }}\"{{ end }}" +
+		"{{ if .Roles }} role=\"{{ .Roles }}\"{{ end }}>\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"<simpara>{{ .Content }}</simpara>\n" +
+		"</{{ .Kind }}>\n{{ end }}"
+
+	embeddedParagraphTmpl = "<simpara>{{ .CheckStyle }}{{ .Content }}</simpara>\n"
+
+	verseParagraphTmpl = `<blockquote` +
+		`{{ if .ID }} xml:id="{{ .ID }}"{{ end }}` +
+		">\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"{{ if .Attribution.First }}<attribution>\n" +
+		"{{ .Attribution.First }}\n" +
+		"{{ if .Attribution.Second }}<citetitle>{{ .Attribution.Second }}</citetitle>\n{{ end }}" +
+		"</attribution>\n{{ end }}" +
+		"<literallayout>{{ .Content }}</literallayout>\n" +
+		"</blockquote>\n"
+
+	quoteParagraphTmpl = `<blockquote` +
+		`{{ if .ID }} xml:id="{{ .ID }}"{{ end }}` +
+		">\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"{{ if .Attribution.First }}<attribution>\n" +
+		"{{ .Attribution.First }}\n" +
+		"{{ if .Attribution.Second }}<citetitle>{{ .Attribution.Second }}</citetitle>\n{{ end }}" +
+		"</attribution>\n{{ end }}" +
+		"<simpara>{{ .Content }}</simpara>\n" +
+		"</blockquote>\n"
+
+	manpageNameParagraphTmpl = "<simpara>{{ .Content }}</simpara>\n"
+
+	thematicBreakTmpl = "<simpara><?asciidoc-hr?></simpara>\n"
+)
@@ -0,0 +1,62 @@
+package docbook5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("paragraphs", func() {
+
+	It("paragraph with special characters and quoted text", func() {
+		source := `*bold content*, _italic_, ` + "`mono`" + `, ~sub~, ^sup^ and #marked#
+& more content afterwards`
+		expected := `<simpara><emphasis role="strong">bold content</emphasis>, <emphasis>italic</emphasis>, <literal>mono</literal>, <subscript>sub</subscript>, <superscript>sup</superscript> and <phrase role="marked">marked</phrase>
+&amp; more content afterwards</simpara>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("paragraph with an ID and a title", func() {
+		source := `[#foo]
+.a title
+some content`
+		expected := `<formalpara xml:id="foo">
+<title>a title</title>
+<para>some content</para>
+</formalpara>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("admonition paragraph", func() {
+		source := `WARNING: watch out!`
+		expected := `<warning>
+<simpara>watch out!</simpara>
+</warning>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("admonition block with title", func() {
+		source := `.a title
+[NOTE]
+====
+some content
+====`
+		expected := `<note>
+<title>a title</title>
+<simpara>some content</simpara>
+</note>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("thematic break", func() {
+		source := `'''`
+		expected := `<simpara><?asciidoc-hr?></simpara>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+})
@@ -0,0 +1,6 @@
+package docbook5
+
+const (
+	// the preamble has no wrapper element in a DocBook article
+	preambleTmpl = `{{ .Content }}`
+)
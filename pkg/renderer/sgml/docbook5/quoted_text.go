@@ -0,0 +1,10 @@
+package docbook5
+
+const (
+	boldTextTmpl        = `{{ if .Roles }}<phrase role="{{ .Roles }}">{{ end }}<emphasis{{ if .ID }} xml:id="{{ .ID }}"{{ end }} role="strong">{{ .Content }}</emphasis>{{ if .Roles }}</phrase>{{ end }}`
+	italicTextTmpl      = `{{ if .Roles }}<phrase role="{{ .Roles }}">{{ end }}<emphasis{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>{{ .Content }}</emphasis>{{ if .Roles }}</phrase>{{ end }}`
+	monospaceTextTmpl   = `{{ if .Roles }}<phrase role="{{ .Roles }}">{{ end }}<literal{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>{{ .Content }}</literal>{{ if .Roles }}</phrase>{{ end }}`
+	subscriptTextTmpl   = `{{ if .Roles }}<phrase role="{{ .Roles }}">{{ end }}<subscript{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>{{ .Content }}</subscript>{{ if .Roles }}</phrase>{{ end }}`
+	superscriptTextTmpl = `{{ if .Roles }}<phrase role="{{ .Roles }}">{{ end }}<superscript{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>{{ .Content }}</superscript>{{ if .Roles }}</phrase>{{ end }}`
+	markedTextTmpl      = `<phrase{{ if .ID }} xml:id="{{ .ID }}"{{ end }} role="{{ if .Roles }}{{ .Roles }}{{ else }}marked{{ end }}">{{ .Content }}</phrase>`
+)
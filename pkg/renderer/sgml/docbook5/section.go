@@ -0,0 +1,11 @@
+package docbook5
+
+const (
+	// the element is named after the kind of section (`section`, `part`, `chapter`, `appendix`, `preface`, etc.)
+	sectionContentTmpl = `{{ $elt := .Kind }}{{ if eq .Kind "acknowledgments" }}{{ $elt = "acknowledgements" }}{{ end }}<{{ $elt }}{{ if .ID }} xml:id="{{ .ID }}"{{ end }}{{ if .Roles }} role="{{ .Roles }}"{{ end }}>
+{{ .Header }}{{ .Content }}</{{ $elt }}>
+`
+	// section numbers are left to the DocBook toolchain
+	sectionTitleTmpl = `<title>{{ .Content }}</title>
+`
+)
@@ -0,0 +1,77 @@
+package docbook5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("sections", func() {
+
+	It("nested sections", func() {
+		source := `== Section A
+
+content A
+
+=== Section A.1
+
+content A.1`
+		expected := `<section xml:id="_Section_A">
+<title>Section A</title>
+<simpara>content A</simpara>
+<section xml:id="_Section_A_1">
+<title>Section A.1</title>
+<simpara>content A.1</simpara>
+</section>
+</section>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("internal and external cross references", func() {
+		source := `== Section A
+
+see <<_Section_A,this section>> or https://example.com[the website]`
+		expected := `<section xml:id="_Section_A">
+<title>Section A</title>
+<simpara>see <link linkend="_Section_A">this section</link> or <link xl:href="https://example.com">the website</link></simpara>
+</section>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("parts, chapters and special sections in book", func() {
+		source := `= Book Title
+:doctype: book
+
+[preface]
+== Preface
+
+= Part One
+
+== Chapter A
+
+=== Section A.1
+
+[appendix]
+== Tools`
+		expected := `<preface xml:id="_Preface">
+<title>Preface</title>
+</preface>
+<part xml:id="_Part_One">
+<title>Part One</title>
+<chapter xml:id="_Chapter_A">
+<title>Chapter A</title>
+<section xml:id="_Section_A_1">
+<title>Section A.1</title>
+</section>
+</chapter>
+<appendix xml:id="_Tools">
+<title>Tools</title>
+</appendix>
+</part>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+})
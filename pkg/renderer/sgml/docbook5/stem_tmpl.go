@@ -0,0 +1,13 @@
+package docbook5
+
+const (
+	stemBlockTmpl = "{{ if .Title }}<equation{{ else }}<informalequation{{ end }}" +
+		"{{ if .ID }} xml:id=\"{{ .ID }}\"{{ end }}" +
+		"{{ if .Roles }} role=\"{{ .Roles }}\"{{ end }}>\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"<alt>{{ .Source }}</alt>\n" +
+		"{{ .Content }}\n" +
+		"{{ if .Title }}</equation>{{ else }}</informalequation>{{ end }}\n"
+
+	inlineStemTmpl = "<inlineequation><alt>{{ .Source }}</alt>{{ .Content }}</inlineequation>"
+)
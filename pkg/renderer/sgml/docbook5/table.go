@@ -0,0 +1,42 @@
+package docbook5
+
+const (
+	tableTmpl = "{{ if .Title }}<table{{ else }}<informaltable{{ end }}" +
+		"{{ if .ID }} xml:id=\"{{ .ID }}\"{{ end }}" +
+		"{{ if .Roles }} role=\"{{ .Roles }}\"{{ end }}" +
+		" frame=\"{{ .Frame }}\"" +
+		" rowsep=\"{{ if or (eq .Grid \"all\") (eq .Grid \"rows\") }}1{{ else }}0{{ end }}\"" +
+		" colsep=\"{{ if or (eq .Grid \"all\") (eq .Grid \"cols\") }}1{{ else }}0{{ end }}\"" +
+		"{{ if .Width }} style=\"width: {{ .Width }}%;\"{{ end }}" +
+		">\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"{{ if .Body }}" +
+		"<tgroup cols=\"{{ len .Columns }}\">\n" +
+		"{{ range $i, $w := .Columns }}<colspec colname=\"col_{{ $i }}\"" +
+		"{{ if $w.Width }} colwidth=\"{{ $w.Width }}*\"{{ end }}" +
+		"/>\n{{ end}}" +
+		"{{ .Header }}" +
+		"{{ .Footer }}" + // in DocBook, the footer comes before the body
+		"{{ .Body }}" +
+		"</tgroup>\n" +
+		"{{ end }}" +
+		"{{ if .Title }}</table>{{ else }}</informaltable>{{ end }}\n"
+
+	tableBodyTmpl = "{{ if .Content }}<tbody>\n{{ .Content }}</tbody>\n{{ end }}"
+
+	tableHeaderTmpl = "{{ if .Content }}<thead>\n<row>\n{{ .Content }}</row>\n</thead>\n{{ end }}"
+
+	tableHeaderCellTmpl = "<entry{{ if gt .ColSpan 1 }} namest=\"col_{{ .Column }}\" nameend=\"col_{{ .LastColumn }}\"{{ end }}{{ if gt .RowSpan 1 }} morerows=\"{{ .MoreRows }}\"{{ end }} align=\"{{ halignValue .HAlign }}\" valign=\"{{ valignValue .VAlign }}\">{{ .Content }}</entry>\n"
+
+	tableFooterTmpl = "{{ if .Content }}<tfoot>\n<row>\n{{ .Content }}</row>\n</tfoot>\n{{ end }}"
+
+	tableFooterCellTmpl = "<entry{{ if gt .ColSpan 1 }} namest=\"col_{{ .Column }}\" nameend=\"col_{{ .LastColumn }}\"{{ end }}{{ if gt .RowSpan 1 }} morerows=\"{{ .MoreRows }}\"{{ end }} align=\"{{ halignValue .HAlign }}\" valign=\"{{ valignValue .VAlign }}\">{{ .Content }}</entry>\n"
+
+	tableRowTmpl = "<row>\n{{ .Content }}</row>\n"
+
+	tableCellTmpl = "<entry{{ if gt .ColSpan 1 }} namest=\"col_{{ .Column }}\" nameend=\"col_{{ .LastColumn }}\"{{ end }}{{ if gt .RowSpan 1 }} morerows=\"{{ .MoreRows }}\"{{ end }} align=\"{{ halignValue .HAlign }}\" valign=\"{{ valignValue .VAlign }}\">{{ .Content }}</entry>\n"
+
+	tableCellBlockTmpl = "{{ .Content }}"
+
+	tableCellLiteralTmpl = "<literallayout class=\"monospaced\">{{ .Content }}</literallayout>"
+)
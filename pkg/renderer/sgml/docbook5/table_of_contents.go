@@ -0,0 +1,8 @@
+package docbook5
+
+const (
+	// the table of contents is generated by the DocBook toolchain
+	tocRootTmpl    = "{{- /* table of contents is generated by the DocBook toolchain */ -}}"
+	tocSectionTmpl = "{{- /* table of contents is generated by the DocBook toolchain */ -}}"
+	tocEntryTmpl   = "{{- /* table of contents is generated by the DocBook toolchain */ -}}"
+)
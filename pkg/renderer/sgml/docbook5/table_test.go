@@ -0,0 +1,73 @@
+package docbook5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tables", func() {
+
+	It("table with title, header and alignments", func() {
+		source := `.a title
+[cols="<,>",options="header"]
+|===
+|A |B
+|1 |2
+|===`
+		expected := `<table frame="all" rowsep="1" colsep="1">
+<title>a title</title>
+<tgroup cols="2">
+<colspec colname="col_0" colwidth="50*"/>
+<colspec colname="col_1" colwidth="50*"/>
+<thead>
+<row>
+<entry align="left" valign="top">A</entry>
+<entry align="right" valign="top">B</entry>
+</row>
+</thead>
+<tbody>
+<row>
+<entry align="left" valign="top"><simpara>1</simpara></entry>
+<entry align="right" valign="top"><simpara>2</simpara></entry>
+</row>
+</tbody>
+</tgroup>
+</table>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+
+	It("table with cells spanning columns and rows", func() {
+		source := `|===
+2+| a | b
+.2+| c | d | e
+| f | g
+|===`
+		expected := `<informaltable frame="all" rowsep="1" colsep="1">
+<tgroup cols="3">
+<colspec colname="col_0" colwidth="33.3333*"/>
+<colspec colname="col_1" colwidth="33.3333*"/>
+<colspec colname="col_2" colwidth="33.3334*"/>
+<tbody>
+<row>
+<entry namest="col_0" nameend="col_1" align="left" valign="top"><simpara>a</simpara></entry>
+<entry align="left" valign="top"><simpara>b</simpara></entry>
+</row>
+<row>
+<entry morerows="1" align="left" valign="top"><simpara>c</simpara></entry>
+<entry align="left" valign="top"><simpara>d</simpara></entry>
+<entry align="left" valign="top"><simpara>e</simpara></entry>
+</row>
+<row>
+<entry align="left" valign="top"><simpara>f</simpara></entry>
+<entry align="left" valign="top"><simpara>g</simpara></entry>
+</row>
+</tbody>
+</tgroup>
+</informaltable>
+`
+		Expect(RenderDocBook(source)).To(MatchHTML(expected))
+	})
+})
@@ -0,0 +1,102 @@
+package docbook5
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml"
+)
+
+// Templates returns the default Templates use for DocBook 5.
+func Templates() sgml.Templates {
+	return templates
+}
+
+// the Templates used for DocBook 5.
+var templates = sgml.Templates{
+	AdmonitionBlock:              admonitionBlockTmpl,
+	AdmonitionParagraph:          admonitionParagraphTmpl,
+	Article:                      articleTmpl,
+	ArticleHeader:                articleHeaderTmpl,
+	AudioBlock:                   audioBlockTmpl,
+	BibliographyAnchor:           bibliographyAnchorTmpl,
+	BibliographyBlock:            bibliographyBlockTmpl,
+	BlockImage:                   blockImageTmpl,
+	BoldText:                     boldTextTmpl,
+	CalloutList:                  calloutListTmpl,
+	CalloutListElement:           calloutListElementTmpl,
+	CalloutRef:                   calloutRefTmpl,
+	ConcealedIndexTerm:           concealedIndexTermTmpl,
+	DocumentDetails:              documentDetailsTmpl,
+	DocumentAuthorDetails:        documentAuthorDetailsTmpl,
+	EmbeddedParagraph:            embeddedParagraphTmpl,
+	ExternalCrossReference:       externalCrossReferenceTmpl,
+	ExampleBlock:                 exampleBlockTmpl,
+	FencedBlock:                  fencedBlockTmpl,
+	Footnote:                     footnoteTmpl,
+	FootnoteElement:              footnoteElementTmpl,
+	FootnoteRef:                  footnoteRefTmpl,
+	Footnotes:                    footnotesTmpl,
+	IconFont:                     iconFontTmpl,
+	IconImage:                    iconImageTmpl,
+	IconSVG:                      iconSVGTmpl,
+	IconText:                     iconTextTmpl,
+	Index:                        indexTmpl,
+	IndexEntry:                   indexEntryTmpl,
+	IndexTerm:                    indexTermTmpl,
+	InlineButton:                 inlineButtonTmpl,
+	InlineCitation:               inlineCitationTmpl,
+	InlineIcon:                   inlineIconTmpl,
+	InlineImage:                  inlineImageTmpl,
+	InlineMenu:                   inlineMenuTmpl,
+	InlineStem:                   inlineStemTmpl,
+	InternalCrossReference:       internalCrossReferenceTmpl,
+	InvalidFootnote:              invalidFootnoteTmpl,
+	ItalicText:                   italicTextTmpl,
+	LabeledList:                  labeledListTmpl,
+	LabeledListElement:           labeledListElementTmpl,
+	LabeledListHorizontal:        labeledListHorizontalTmpl,
+	LabeledListHorizontalElement: labeledListHorizontalElementTmpl,
+	LineBreak:                    lineBreakTmpl,
+	Link:                         linkTmpl,
+	ListingBlock:                 listingBlockTmpl,
+	LiteralBlock:                 literalBlockTmpl,
+	ManpageHeader:                manpageHeaderTmpl,
+	ManpageNameParagraph:         manpageNameParagraphTmpl,
+	MarkdownQuoteBlock:           markdownQuoteBlockTmpl,
+	MarkedText:                   markedTextTmpl,
+	MonospaceText:                monospaceTextTmpl,
+	OpenBlock:                    openBlockTmpl,
+	OrderedList:                  orderedListTmpl,
+	OrderedListElement:           orderedListElementTmpl,
+	PassthroughBlock:             passthroughBlock,
+	Paragraph:                    paragraphTmpl,
+	Preamble:                     preambleTmpl,
+	QAndAList:                    qAndAListTmpl,
+	QAndAListElement:             qAndAListElementTmpl,
+	QuoteBlock:                   quoteBlockTmpl,
+	QuoteParagraph:               quoteParagraphTmpl,
+	SectionContent:               sectionContentTmpl,
+	SectionTitle:                 sectionTitleTmpl,
+	SidebarBlock:                 sidebarBlockTmpl,
+	SourceBlock:                  sourceBlockTmpl,
+	StemBlock:                    stemBlockTmpl,
+	SubscriptText:                subscriptTextTmpl,
+	SuperscriptText:              superscriptTextTmpl,
+	Table:                        tableTmpl,
+	TableBody:                    tableBodyTmpl,
+	TableCell:                    tableCellTmpl,
+	TableCellBlock:               tableCellBlockTmpl,
+	TableCellLiteral:             tableCellLiteralTmpl,
+	TableHeader:                  tableHeaderTmpl,
+	TableHeaderCell:              tableHeaderCellTmpl,
+	TableFooter:                  tableFooterTmpl,
+	TableFooterCell:              tableFooterCellTmpl,
+	TableRow:                     tableRowTmpl,
+	ThematicBreak:                thematicBreakTmpl,
+	TocRoot:                      tocRootTmpl,
+	TocEntry:                     tocEntryTmpl,
+	TocSection:                   tocSectionTmpl,
+	UnorderedList:                unorderedListTmpl,
+	UnorderedListElement:         unorderedListElementTmpl,
+	VerseBlock:                   verseBlockTmpl,
+	VerseParagraph:               verseParagraphTmpl,
+	VideoBlock:                   videoBlockTmpl,
+}
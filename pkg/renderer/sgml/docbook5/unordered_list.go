@@ -0,0 +1,12 @@
+package docbook5
+
+const (
+	unorderedListTmpl = `<itemizedlist` +
+		`{{ if .ID }} xml:id="{{ .ID }}"{{ end }}` +
+		`{{ if .Roles }} role="{{ .Roles }}"{{ end }}` +
+		">\n" +
+		"{{ if .Title }}<title>{{ .Title }}</title>\n{{ end }}" +
+		"{{ .Content }}</itemizedlist>\n"
+
+	unorderedListElementTmpl = "<listitem>\n{{ .Content }}</listitem>\n"
+)
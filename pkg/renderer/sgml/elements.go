@@ -45,6 +45,13 @@ func (r *sgmlRenderer) renderListElements(ctx *context, elements []interface{})
 //nolint:gocyclo
 func (r *sgmlRenderer) renderElement(ctx *context, element interface{}) (string, error) {
 	// log.Debugf("rendering element of type `%T`", element)
+	if e, ok := element.(types.WithSourcePosition); ok && e.GetSourcePosition() != nil {
+		previousPosition := ctx.position
+		defer func() {
+			ctx.position = previousPosition
+		}()
+		ctx.position = e.GetSourcePosition()
+	}
 	switch e := element.(type) {
 	case *types.TableOfContents:
 		return r.renderTableOfContents(ctx, e)
@@ -55,7 +62,7 @@ func (r *sgmlRenderer) renderElement(ctx *context, element interface{}) (string,
 	case *types.List:
 		return r.renderList(ctx, e)
 	case *types.Callout:
-		return r.renderCalloutRef(e)
+		return r.renderCalloutRef(ctx, e)
 	case *types.Paragraph:
 		return r.renderParagraph(ctx, e)
 	case *types.InternalCrossReference:
@@ -66,8 +73,16 @@ func (r *sgmlRenderer) renderElement(ctx *context, element interface{}) (string,
 		return r.renderQuotedText(ctx, e)
 	case *types.InlinePassthrough:
 		return r.renderInlinePassthrough(ctx, e)
+	case *types.InlineStem:
+		return r.renderInlineStem(ctx, e)
 	case *types.ImageBlock:
 		return r.renderImageBlock(ctx, e)
+	case *types.DiagramBlock:
+		return r.renderDiagramBlock(ctx, e)
+	case *types.VideoBlock:
+		return r.renderVideoBlock(ctx, e)
+	case *types.AudioBlock:
+		return r.renderAudioBlock(ctx, e)
 	case *types.InlineButton:
 		return r.renderInlineButton(e)
 	case *types.InlineImage:
@@ -85,15 +100,21 @@ func (r *sgmlRenderer) renderElement(ctx *context, element interface{}) (string,
 	case *types.StringElement:
 		return r.renderStringElement(ctx, e)
 	case *types.FootnoteReference:
-		return r.renderFootnoteReference(e)
+		return r.renderFootnoteReference(ctx, e)
 	case *types.LineBreak:
 		return r.renderLineBreak()
+	case *types.BibliographyAnchor:
+		return r.renderBibliographyAnchor(e)
+	case *types.InlineCitation:
+		return r.renderInlineCitation(ctx, e)
+	case *types.BibliographyBlock:
+		return r.renderBibliographyBlock(ctx, e)
 	case *types.UserMacro:
 		return r.renderUserMacro(ctx, e)
 	case *types.IndexTerm:
 		return r.renderIndexTerm(ctx, e)
 	case *types.ConcealedIndexTerm:
-		return r.renderConcealedIndexTerm(e)
+		return r.renderConcealedIndexTerm(ctx, e)
 	case *types.ThematicBreak:
 		return r.renderThematicBreak()
 	case *types.SpecialCharacter:
@@ -103,13 +124,15 @@ func (r *sgmlRenderer) renderElement(ctx *context, element interface{}) (string,
 	case *types.PredefinedAttribute:
 		return r.renderPredefinedAttribute(e)
 	case *types.AttributeDeclaration:
-		ctx.attributes[e.Name] = e.Value
+		ctx.setAttribute(e.Name, e.Value)
 		return "", nil
 	case *types.AttributeReset:
-		delete(ctx.attributes, e.Name)
+		ctx.unsetAttribute(e.Name)
 		return "", nil
 	case *types.FrontMatter:
-		ctx.attributes.AddAll(e.Attributes)
+		for k, v := range e.Attributes {
+			ctx.setAttribute(k, v)
+		}
 		return "", nil
 	default:
 		return "", errors.Errorf("unsupported type of element: %T", element)
@@ -0,0 +1,264 @@
+package sgml
+
+import (
+	"encoding/base64"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// embedAssets returns `true` if the images (and icon images) should be embedded in the document,
+// ie, when the `data-uri` attribute is set or when all assets are embedded
+func embedAssets(ctx *context) bool {
+	return ctx.config.EmbedAssets || ctx.attributes.Has(types.AttrDataURI)
+}
+
+// isRemote returns `true` if the given location is a URL (other than a `file://` URL)
+func isRemote(location string) bool {
+	return strings.Contains(location, "://") && !strings.HasPrefix(location, "file://")
+}
+
+// localPath returns the path to the given local file, relative to the base directory (unless it is absolute)
+func localPath(ctx *context, location string) string {
+	location = strings.TrimPrefix(location, "file://")
+	if filepath.IsAbs(location) {
+		return location
+	}
+	return filepath.Join(baseDir(ctx), location)
+}
+
+// baseDir returns the directory in which the relative paths to the resources of the document (images, stylesheets, etc.)
+// are resolved, ie, the `base dir` setting if set, or the directory of the document otherwise
+func baseDir(ctx *context) string {
+	if ctx.config.BaseDir != "" {
+		return ctx.config.BaseDir
+	}
+	return filepath.Dir(ctx.config.Filename)
+}
+
+// canRead returns `true` if the file at the given path can be read in the safe mode of the configuration,
+// or reports a diagnostic otherwise
+func canRead(ctx *context, path string) bool {
+	if err := ctx.config.SafeMode.CheckAccess(ctx.config.JailDir(), path); err != nil {
+		log.Warn(err.Error())
+		ctx.config.Diagnostics.Warnf(types.RestrictedAccess, ctx.position, "%s", err.Error())
+		return false
+	}
+	return true
+}
+
+// dataURI returns the content of the local file at the given location as a `data:` URI,
+// or the location itself if it is a remote URL or if the file cannot be read in the safe mode of the configuration.
+// If the file cannot be read, then a warning is reported and the `data:` URI has no content.
+func dataURI(ctx *context, location string) string {
+	if isRemote(location) {
+		return location
+	}
+	path := localPath(ctx, location)
+	if !canRead(ctx, path) {
+		return location
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Warnf("image to embed not found or not readable: %s", path)
+		ctx.config.Diagnostics.Warnf(types.MissingImage, ctx.position, "image to embed not found or not readable: %s", path)
+	}
+	return "data:" + mediaType(path, data) + ";base64," + base64.StdEncoding.EncodeToString(data)
+}
+
+// media types which may be unknown to the `mime` package, depending on the platform
+var mediaTypes = map[string]string{
+	".svg":   "image/svg+xml",
+	".ico":   "image/x-icon",
+	".woff":  "font/woff",
+	".woff2": "font/woff2",
+	".ttf":   "font/ttf",
+	".otf":   "font/otf",
+	".eot":   "application/vnd.ms-fontobject",
+}
+
+// mediaType returns the media type of the given content, sniffed from the content itself if possible,
+// or guessed from the extension of the file otherwise (eg: when the file could not be read)
+func mediaType(path string, data []byte) string {
+	if len(data) > 0 {
+		t := strings.SplitN(http.DetectContentType(data), ";", 2)[0]
+		switch {
+		case t != "application/octet-stream" && !strings.HasPrefix(t, "text/"):
+			return t
+		case strings.Contains(string(data[:min(len(data), 1024)]), "<svg"):
+			// SVG is an XML format which is not sniffed by `http.DetectContentType`
+			return "image/svg+xml"
+		}
+	}
+	ext := strings.ToLower(filepath.Ext(path))
+	if t, found := mediaTypes[ext]; found {
+		return t
+	}
+	if t := mime.TypeByExtension(ext); t != "" {
+		return strings.SplitN(t, ";", 2)[0]
+	}
+	return "application/octet-stream"
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// stylesheets returns the stylesheets to link to the document, and the content of the stylesheets to embed in it.
+// The stylesheet set in the `stylesheet` attribute (or the default stylesheet if the attribute is empty and no stylesheet
+// is set in the `css` setting) is embedded, unless the `linkcss` attribute is set. The stylesheets of the `css` setting are linked,
+// unless all assets are embedded.
+func stylesheets(ctx *context) ([]string, []string) {
+	linked := []string{}
+	embedded := []string{}
+	if stylesheet, found := ctx.attributes.GetAsString(types.AttrStylesheet); found && (stylesheet != "" || len(ctx.config.CSS) == 0) {
+		href := stylesheetHref(ctx, stylesheet)
+		switch {
+		case ctx.attributes.Has(types.AttrLinkCSS) && !ctx.config.EmbedAssets:
+			linked = append(linked, href)
+			if ctx.attributes.Has(types.AttrCopyCSS) {
+				copyStylesheet(ctx, stylesheet, href)
+			}
+		case stylesheet == "":
+			embedded = append(embedded, DefaultStylesheet)
+		default:
+			location := href
+			if !isRemote(href) {
+				location = localPath(ctx, href)
+			}
+			if content, ok := embeddedStylesheet(ctx, location); ok {
+				embedded = append(embedded, content)
+			} else {
+				linked = append(linked, href)
+			}
+		}
+	}
+	for _, href := range ctx.config.CSS {
+		if ctx.config.EmbedAssets {
+			if content, ok := embeddedStylesheet(ctx, href); ok {
+				embedded = append(embedded, content)
+				continue
+			}
+		}
+		linked = append(linked, href)
+	}
+	return linked, embedded
+}
+
+// stylesheetHref returns the location of the given stylesheet (or of the default stylesheet if empty)
+// in the `stylesdir` directory, unless it is a URL or an absolute path
+func stylesheetHref(ctx *context, stylesheet string) string {
+	if stylesheet == "" {
+		stylesheet = DefaultStylesheetName
+	}
+	if isRemote(stylesheet) || filepath.IsAbs(stylesheet) {
+		return stylesheet
+	}
+	stylesdir := ctx.attributes.GetAsStringWithDefault(types.AttrStylesDir, ".")
+	if stylesdir == "" {
+		return stylesheet
+	}
+	return strings.TrimSuffix(stylesdir, "/") + "/" + stylesheet
+}
+
+// copyStylesheet copies the linked stylesheet in the output directory (if known), at the location of the link.
+// The copied file is the one set in the `copycss` attribute, or the stylesheet itself (relative to the document),
+// or the default stylesheet.
+func copyStylesheet(ctx *context, stylesheet, href string) {
+	if ctx.config.OutputDir == "" || isRemote(href) || filepath.IsAbs(href) {
+		return
+	}
+	dest := filepath.Join(ctx.config.OutputDir, filepath.FromSlash(href))
+	if err := ctx.config.SafeMode.CheckAccess(ctx.config.OutputDir, dest); err != nil {
+		log.Warn(err.Error())
+		ctx.config.Diagnostics.Warnf(types.RestrictedAccess, ctx.position, "%s", err.Error())
+		return
+	}
+	source := ctx.attributes.GetAsStringWithDefault(types.AttrCopyCSS, "")
+	if source == "" && stylesheet != "" {
+		source = href
+	}
+	data := []byte(DefaultStylesheet + "\n")
+	if source != "" {
+		path := localPath(ctx, source)
+		if samePath(path, dest) {
+			// nothing to copy (eg: the output directory is the directory of the document)
+			return
+		}
+		if !canRead(ctx, path) {
+			return
+		}
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			log.Warnf("stylesheet to copy not found or not readable: %s", path)
+			ctx.config.Diagnostics.Warnf(types.MissingStylesheet, nil, "stylesheet to copy not found or not readable: %s", path)
+			return
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		log.Warnf("unable to copy stylesheet to %s: %v", dest, err)
+		return
+	}
+	if err := os.WriteFile(dest, data, 0644); err != nil { //nolint:gosec
+		log.Warnf("unable to copy stylesheet to %s: %v", dest, err)
+	}
+}
+
+var cssURLRx = regexp.MustCompile(`url\(\s*(['"]?)([^'")]+)['"]?\s*\)`)
+
+// embeddedStylesheet returns the content of the given local stylesheet (relative to the current directory or to the document),
+// in which the (local) fonts and images are embedded as `data:` URIs.
+// Returns `false` if the stylesheet is remote or cannot be read.
+func embeddedStylesheet(ctx *context, href string) (string, bool) {
+	if isRemote(href) {
+		return "", false
+	}
+	path := strings.TrimPrefix(href, "file://")
+	if _, err := os.Stat(path); err != nil && !filepath.IsAbs(path) {
+		path = localPath(ctx, href)
+	}
+	if !canRead(ctx, path) {
+		return "", false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Warnf("stylesheet to embed not found or not readable: %s", href)
+		ctx.config.Diagnostics.Warnf(types.MissingStylesheet, nil, "stylesheet to embed not found or not readable: %s", href)
+		return "", false
+	}
+	dir := filepath.Dir(path)
+	return cssURLRx.ReplaceAllStringFunc(strings.TrimRight(string(data), "\n"), func(u string) string {
+		location := cssURLRx.FindStringSubmatch(u)[2]
+		if strings.HasPrefix(location, "data:") || strings.HasPrefix(location, "#") || isRemote(location) {
+			return u
+		}
+		// ignore the query and fragment (eg: `font.woff?v=4.7.0#iefix`)
+		p := location
+		if i := strings.IndexAny(p, "?#"); i >= 0 {
+			p = p[:i]
+		}
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(dir, p)
+		}
+		if !canRead(ctx, p) {
+			return u
+		}
+		content, err := os.ReadFile(p)
+		if err != nil {
+			log.Warnf("asset of stylesheet '%s' not found or not readable: %s", href, location)
+			ctx.config.Diagnostics.Warnf(types.MissingStylesheet, nil, "asset of stylesheet '%s' not found or not readable: %s", href, location)
+			return u
+		}
+		return `url("data:` + mediaType(p, content) + ";base64," + base64.StdEncoding.EncodeToString(content) + `")`
+	}), true
+}
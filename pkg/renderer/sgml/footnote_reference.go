@@ -7,7 +7,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (r *sgmlRenderer) renderFootnoteReference(note *types.FootnoteReference) (string, error) {
+func (r *sgmlRenderer) renderFootnoteReference(ctx *context, note *types.FootnoteReference) (string, error) {
 	result := &strings.Builder{}
 	if note.ID != types.InvalidFootnoteReference && !note.Duplicate {
 		// valid case for a footnote with content, with our without an explicit reference
@@ -15,12 +15,19 @@ func (r *sgmlRenderer) renderFootnoteReference(note *types.FootnoteReference) (s
 		if err != nil {
 			return "", errors.Wrap(err, "unable to load footnote template")
 		}
+		// also provide the footnote content, for backends which render footnotes inline
+		content, err := r.renderFootnoteContent(ctx, ctx.footnote(note.ID))
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render footnote")
+		}
 		if err := tmpl.Execute(result, struct {
-			ID  int
-			Ref string
+			ID      int
+			Ref     string
+			Content string
 		}{
-			ID:  note.ID,
-			Ref: note.Ref,
+			ID:      note.ID,
+			Ref:     note.Ref,
+			Content: content,
 		}); err != nil {
 			return "", errors.Wrap(err, "unable to render footnote")
 		}
@@ -81,13 +88,10 @@ func (r *sgmlRenderer) renderFootnotes(ctx *context, notes []*types.Footnote) (s
 }
 
 func (r *sgmlRenderer) renderFootnoteElement(ctx *context, note *types.Footnote) (string, error) {
-	content, err := r.renderInlineElements(ctx, note.Elements)
+	content, err := r.renderFootnoteContent(ctx, note)
 	if err != nil {
-		return "", errors.Wrapf(err, "unable to render foot note content")
+		return "", err
 	}
-	content = strings.TrimSpace(content)
-	// Note: Asciidoctor will render the footnote content on a single line
-	content = strings.ReplaceAll(content, "\n", " ")
 	return r.execute(r.footnoteElement, struct {
 		Context *context
 		ID      int
@@ -100,3 +104,16 @@ func (r *sgmlRenderer) renderFootnoteElement(ctx *context, note *types.Footnote)
 		Content: string(content),
 	})
 }
+
+func (r *sgmlRenderer) renderFootnoteContent(ctx *context, note *types.Footnote) (string, error) {
+	if note == nil {
+		return "", nil
+	}
+	content, err := r.renderInlineElements(ctx, note.Elements)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to render foot note content")
+	}
+	content = strings.TrimSpace(content)
+	// Note: Asciidoctor will render the footnote content on a single line
+	return strings.ReplaceAll(content, "\n", " "), nil
+}
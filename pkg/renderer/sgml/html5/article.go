@@ -11,8 +11,12 @@ const (
 {{ end }}{{ if .Description }}<meta name="description" content="{{ .Description }}">
 {{ end }}{{ if .Authors }}<meta name="author" content="{{ .Authors }}">
 {{ end }}{{ range $css := .CSS }}<link type="text/css" rel="stylesheet" href="{{ $css }}">
+{{ end }}{{ range $css := .EmbeddedCSS }}<style>
+{{ $css }}
+</style>
 {{ end }}<title>{{ .Title }}</title>
-</head>
+{{ if .Docinfo }}{{ .Docinfo }}
+{{ end }}</head>
 <body{{ if .ID }} id="{{ .ID }}"{{ end }} class="{{ .Doctype }}{{ if .Roles }} {{ .Roles }}{{ end }}">
 {{ if .IncludeHTMLBodyHeader }}{{ .Header }}{{ end }}<div id="content">
 {{ .Content }}</div>
@@ -22,6 +26,7 @@ const (
 {{ end }}Last updated {{ .LastUpdated }}
 </div>
 </div>
+{{ end }}{{ if .DocinfoFooter }}{{ .DocinfoFooter }}
 {{ end }}</body>
 </html>
 `
@@ -0,0 +1,18 @@
+package html5
+
+const (
+	bibliographyAnchorTmpl = `<a id="{{ .ID }}"></a>[{{ .Label }}]`
+
+	inlineCitationTmpl = "{{ .Content }}"
+
+	bibliographyBlockTmpl = `<div{{ if .ID }} id="{{ .ID }}"{{ end }}` +
+		` class="ulist bibliography` +
+		`{{ if .Roles }} {{ .Roles }}{{ end }}"` +
+		">\n" +
+		"{{ if .Title }}<div class=\"title\">{{ .Title }}</div>\n{{ end }}" +
+		"<ul class=\"bibliography\">\n" +
+		"{{ range .Entries }}<li>\n" +
+		"<p><a id=\"{{ .ID }}\"></a>{{ if .Label }}{{ .Label }} {{ end }}{{ .Content }}</p>\n" +
+		"</li>\n{{ end }}" +
+		"</ul>\n</div>\n"
+)
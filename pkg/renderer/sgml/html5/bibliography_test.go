@@ -0,0 +1,119 @@
+package html5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("bibliographies", func() {
+
+	It("with anchors in bibliography section", func() {
+		source := `The Pragmatic Programmer <<pp>> should be required reading.
+
+[bibliography]
+== References
+
+* [[[pp]]] Andy Hunt and Dave Thomas. The Pragmatic Programmer.
+* [[[gof,Gang of Four]]] Erich Gamma et al. Design Patterns.`
+		expected := `<div class="paragraph">
+<p>The Pragmatic Programmer <a href="#pp">[pp]</a> should be required reading.</p>
+</div>
+<div class="sect1">
+<h2 id="_references">References</h2>
+<div class="sectionbody">
+<div class="ulist bibliography">
+<ul class="bibliography">
+<li>
+<p><a id="pp"></a>[pp] Andy Hunt and Dave Thomas. The Pragmatic Programmer.</p>
+</li>
+<li>
+<p><a id="gof"></a>[Gang of Four] Erich Gamma et al. Design Patterns.</p>
+</li>
+</ul>
+</div>
+</div>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("with numeric citations", func() {
+		source := `:bibtex-file: ../../../../test/bibliography/references.bib
+
+See cite:[lane2012, hunt2000(p. 42)] and citenp:[brown2008].
+
+.References
+bibliography::[]`
+		expected := `<div class="paragraph">
+<p>See [<a href="#_bib_lane2012">1</a>, <a href="#_bib_hunt2000">2, p. 42</a>] and <a href="#_bib_brown2008">3</a>.</p>
+</div>
+<div class="ulist bibliography">
+<div class="title">References</div>
+<ul class="bibliography">
+<li>
+<p><a id="_bib_lane2012"></a>[1] Lane, John. Writing Documentation. Journal of Technical Writing, 2012.</p>
+</li>
+<li>
+<p><a id="_bib_hunt2000"></a>[2] Andrew Hunt and David Thomas. The Pragmatic Programmer. Addison-Wesley, 2000.</p>
+</li>
+<li>
+<p><a id="_bib_brown2008"></a>[3] Alice Brown, Bob Green and Carol White. Lightweight Markup Languages. Proceedings of the Markup Conference, 2008.</p>
+</li>
+</ul>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("with author-year citations in alphabetical order", func() {
+		source := `:bibtex-file: ../../../../test/bibliography/references.bib
+:bibtex-style: author-year
+:bibtex-order: alphabetical
+
+See cite:[lane2012, hunt2000(p. 42)] and citenp:[brown2008].
+
+bibliography::[]`
+		expected := `<div class="paragraph">
+<p>See (<a href="#_bib_lane2012">Lane, 2012</a>; <a href="#_bib_hunt2000">Hunt and Thomas, 2000, p. 42</a>) and <a href="#_bib_brown2008">Brown et al. (2008)</a>.</p>
+</div>
+<div class="ulist bibliography">
+<ul class="bibliography">
+<li>
+<p><a id="_bib_brown2008"></a>Alice Brown, Bob Green and Carol White (2008). Lightweight Markup Languages. Proceedings of the Markup Conference.</p>
+</li>
+<li>
+<p><a id="_bib_hunt2000"></a>Andrew Hunt and David Thomas (2000). The Pragmatic Programmer. Addison-Wesley.</p>
+</li>
+<li>
+<p><a id="_bib_lane2012"></a>Lane, John (2012). Writing Documentation. Journal of Technical Writing.</p>
+</li>
+</ul>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("with unknown citation", func() {
+		source := `:bibtex-file: ../../../../test/bibliography/references.bib
+
+See cite:[unknown].`
+		expected := `<div class="paragraph">
+<p>See [[unknown]].</p>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("with missing bibliography file", func() {
+		source := `:bibtex-file: missing.bib
+
+See cite:[lane2012].`
+		expected := `<div class="paragraph">
+<p>See [[lane2012]].</p>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+})
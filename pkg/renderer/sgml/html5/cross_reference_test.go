@@ -300,6 +300,65 @@ some content linked to xref:{foo}.adoc[another_doc()]!`
 			expected := `<div class="paragraph">
 <p>some content linked to <a href="foo.html">foo.html</a>!</p>
 </div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("to other documents", func() {
+
+		It("to section in other doc using shorthand syntax", func() {
+			source := `see <<other.adoc#section_a>>`
+			expected := `<div class="paragraph">
+<p>see <a href="other.html#section_a">other.html</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("to section in other doc using shorthand syntax with label", func() {
+			source := `see <<other.adoc#section_a,Section A>>`
+			expected := `<div class="paragraph">
+<p>see <a href="other.html#section_a">Section A</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("to section in other doc using macro syntax", func() {
+			source := `see xref:other.adoc#section_a[]`
+			expected := `<div class="paragraph">
+<p>see <a href="other.html#section_a">other.html</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("to section in current doc using macro syntax", func() {
+			source := `[#section_a]
+== Section A
+
+see xref:test.adoc#section_a[]`
+			expected := `<div class="sect1">
+<h2 id="section_a">Section A</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>see <a href="#section_a">Section A</a></p>
+</div>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("with output file suffix and relative file prefix", func() {
+			source := `:outfilesuffix: .htm
+:relfileprefix: ../
+
+see xref:other.adoc#section_a[] and <<other.adoc#>>`
+			expected := `<div class="paragraph">
+<p>see <a href="../other.htm#section_a">../other.htm</a> and <a href="../other.htm">../other.htm</a></p>
+</div>
 `
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 		})
@@ -0,0 +1,266 @@
+package html5_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("diagrams", func() {
+
+	var dir string
+	var conversions int
+
+	// a converter which wraps the source of the diagram in an SVG element
+	convert := func(source, format string) ([]byte, error) {
+		conversions++
+		return []byte(`<svg format="` + format + `">` + source + `</svg>`), nil
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "libasciidoc-diagrams")
+		Expect(err).NotTo(HaveOccurred())
+		conversions = 0
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("block with target", func() {
+		source := `:imagesdir: images
+
+.States
+[graphviz,states]
+----
+digraph { a -> b }
+----`
+		expected := `<div class="imageblock">
+<div class="content">
+<img src="images/states.svg" alt="states">
+</div>
+<div class="title">Figure 1. States</div>
+</div>
+`
+		Expect(RenderHTML(source,
+			configuration.WithBaseDir(dir),
+			configuration.WithDiagramConverter("graphviz", convert),
+		)).To(MatchHTML(expected))
+		Expect(os.ReadFile(filepath.Join(dir, "images", "states.svg"))).To(Equal([]byte(`<svg format="svg">digraph { a -> b }</svg>`)))
+	})
+
+	It("block without target", func() {
+		source := `[plantuml,format=png]
+....
+Alice -> Bob: hello
+....`
+		Expect(RenderHTML(source,
+			configuration.WithBaseDir(dir),
+			configuration.WithDiagramConverter("plantuml", convert),
+		)).To(MatchRegexp(`<img src="diag-[0-9a-f]{40}\.png" alt="Diagram">`))
+		images, err := filepath.Glob(filepath.Join(dir, "diag-*.png"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(images).To(HaveLen(1))
+	})
+
+	It("block in imagesoutdir", func() {
+		source := `:imagesdir: images
+:imagesoutdir: generated
+
+[dot,graph]
+digraph { a -> b }`
+		expected := `<div class="imageblock">
+<div class="content">
+<img src="images/graph.svg" alt="graph">
+</div>
+</div>
+`
+		Expect(RenderHTML(source,
+			configuration.WithBaseDir(dir),
+			configuration.WithDiagramConverter("dot", convert),
+		)).To(MatchHTML(expected))
+		Expect(filepath.Join(dir, "generated", "graph.svg")).To(BeARegularFile())
+	})
+
+	It("block macro", func() {
+		err := os.WriteFile(filepath.Join(dir, "sequence.puml"), []byte("Alice -> Bob: hello"), 0644)
+		Expect(err).NotTo(HaveOccurred())
+		source := `plantuml::sequence.puml[sequence,width=200]`
+		expected := `<div class="imageblock">
+<div class="content">
+<img src="sequence.svg" alt="sequence" width="200">
+</div>
+</div>
+`
+		Expect(RenderHTML(source,
+			configuration.WithBaseDir(dir),
+			configuration.WithDiagramConverter("plantuml", convert),
+		)).To(MatchHTML(expected))
+		Expect(os.ReadFile(filepath.Join(dir, "sequence.svg"))).To(Equal([]byte(`<svg format="svg">Alice -> Bob: hello</svg>`)))
+	})
+
+	It("should reuse cached image", func() {
+		source := `[graphviz,states]
+----
+digraph { a -> b }
+----`
+		for i := 0; i < 2; i++ {
+			_, err := RenderHTML(source,
+				configuration.WithBaseDir(dir),
+				configuration.WithDiagramConverter("graphviz", convert),
+			)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(conversions).To(Equal(1))
+		// convert again when the source changed
+		_, err := RenderHTML(`[graphviz,states]
+----
+digraph { a -> c }
+----`,
+			configuration.WithBaseDir(dir),
+			configuration.WithDiagramConverter("graphviz", convert),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conversions).To(Equal(2))
+		Expect(os.ReadFile(filepath.Join(dir, "states.svg"))).To(Equal([]byte(`<svg format="svg">digraph { a -> c }</svg>`)))
+	})
+
+	It("should render source when no converter is registered", func() {
+		source := `[ditaa]
+----
++---+
+| a |
++---+
+----`
+		expected := `<div class="listingblock">
+<div class="content">
+<pre>+---+
+| a |
++---+</pre>
+</div>
+</div>
+`
+		diagnostics := types.NewDiagnostics()
+		Expect(RenderHTML(source,
+			configuration.WithBaseDir(dir),
+			configuration.WithDiagnostics(diagnostics),
+		)).To(MatchHTML(expected))
+		Expect(diagnostics.All()).To(HaveLen(1))
+		Expect(diagnostics.All()[0].Code).To(Equal(types.FailedDiagram))
+		Expect(diagnostics.All()[0].Message).To(Equal("unable to convert diagram: no converter registered for the 'ditaa' diagrams"))
+	})
+
+	It("should render macro when source file is missing", func() {
+		source := `plantuml::missing.puml[]`
+		expected := `<div class="listingblock">
+<div class="content">
+<pre>plantuml::missing.puml[]</pre>
+</div>
+</div>
+`
+		diagnostics := types.NewDiagnostics()
+		Expect(RenderHTML(source,
+			configuration.WithBaseDir(dir),
+			configuration.WithDiagramConverter("plantuml", convert),
+			configuration.WithDiagnostics(diagnostics),
+		)).To(MatchHTML(expected))
+		Expect(conversions).To(Equal(0))
+		Expect(diagnostics.All()).To(HaveLen(1))
+		Expect(diagnostics.All()[0].Code).To(Equal(types.FailedDiagram))
+	})
+
+	Context("safe modes", func() {
+
+		It("should not write outside of base dir with target in secure mode", func() {
+			source := `[graphviz,../../escaped,svg]
+----
+digraph { a -> b }
+----`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre>digraph { a -&gt; b }</pre>
+</div>
+</div>
+`
+			diagnostics := types.NewDiagnostics()
+			Expect(RenderHTML(source,
+				configuration.WithBaseDir(filepath.Join(dir, "doc", "in")),
+				configuration.WithSafeMode(configuration.Secure),
+				configuration.WithDiagramConverter("graphviz", convert),
+				configuration.WithDiagnostics(diagnostics),
+			)).To(MatchHTML(expected))
+			Expect(conversions).To(Equal(0))
+			Expect(filepath.Join(dir, "doc", "escaped.svg")).NotTo(BeAnExistingFile())
+			Expect(diagnostics.All()).To(HaveLen(1))
+			Expect(diagnostics.All()[0].Code).To(Equal(types.RestrictedAccess))
+			Expect(diagnostics.All()[0].Message).To(Equal("access to '../../escaped.svg' is restricted: the target and format of a diagram cannot contain a path separator"))
+		})
+
+		It("should not write outside of base dir with format in safe mode", func() {
+			source := `[graphviz,states,format=svg/../../../escaped]
+----
+digraph { a -> b }
+----`
+			diagnostics := types.NewDiagnostics()
+			_, err := RenderHTML(source,
+				configuration.WithBaseDir(dir),
+				configuration.WithSafeMode(configuration.Safe),
+				configuration.WithDiagramConverter("graphviz", convert),
+				configuration.WithDiagnostics(diagnostics),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conversions).To(Equal(0))
+			Expect(diagnostics.All()).To(HaveLen(1))
+			Expect(diagnostics.All()[0].Code).To(Equal(types.RestrictedAccess))
+		})
+
+		It("should not write outside of base dir with imagesoutdir in safe mode", func() {
+			source := `:imagesoutdir: ../generated
+
+[graphviz,states]
+----
+digraph { a -> b }
+----`
+			diagnostics := types.NewDiagnostics()
+			_, err := RenderHTML(source,
+				configuration.WithBaseDir(filepath.Join(dir, "doc")),
+				configuration.WithSafeMode(configuration.Safe),
+				configuration.WithDiagramConverter("graphviz", convert),
+				configuration.WithDiagnostics(diagnostics),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conversions).To(Equal(0))
+			Expect(filepath.Join(dir, "generated")).NotTo(BeAnExistingFile())
+			Expect(diagnostics.All()).To(HaveLen(1))
+			Expect(diagnostics.All()[0].Code).To(Equal(types.RestrictedAccess))
+			Expect(diagnostics.All()[0].Message).To(HavePrefix("access to '" + filepath.Join(dir, "generated", "states.svg") + "' is restricted: the file is outside of"))
+		})
+
+		It("should ignore absolute imagesoutdir in safe mode", func() {
+			outdir, err := os.MkdirTemp("", "libasciidoc-diagrams-out")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(outdir)
+			source := `:imagesoutdir: ` + outdir + `
+
+[graphviz,states]
+----
+digraph { a -> b }
+----`
+			_, err = RenderHTML(source,
+				configuration.WithBaseDir(dir),
+				configuration.WithSafeMode(configuration.Safe),
+				configuration.WithDiagramConverter("graphviz", convert),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filepath.Join(outdir, "states.svg")).NotTo(BeAnExistingFile())
+			Expect(filepath.Join(dir, "states.svg")).To(BeARegularFile())
+		})
+	})
+})
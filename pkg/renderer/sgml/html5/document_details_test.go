@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -30,6 +31,9 @@ v1.0, March 22, 2020: Containment
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
 <meta name="author" content="Xavier">
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -64,8 +68,10 @@ Last updated {{ .LastUpdated }}
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 
@@ -82,6 +88,9 @@ John Foo Doe <johndoe@example.com>; Jane Doe <janedoe@example.com>`
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
 <meta name="author" content="John Foo Doe; Jane Doe">
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -111,8 +120,10 @@ Last updated {{ .LastUpdated }}
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 
@@ -129,6 +140,9 @@ some content`
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
 <meta name="description" content="a description">
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -155,8 +169,10 @@ Last updated {{ .LastUpdated }}
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 
@@ -179,6 +195,9 @@ Last updated {{ .LastUpdated }}
 <meta name="generator" content="libasciidoc">
 <meta name="description" content="a long description on multiple lines.">
 <meta name="author" content="Xavier">
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -208,8 +227,10 @@ Last updated {{ .LastUpdated }}
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 	})
@@ -229,6 +250,9 @@ a paragraph`
 <meta http-equiv="X-UA-Compatible" content="IE=edge">
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -255,8 +279,10 @@ Last updated {{ .LastUpdated }}
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 
@@ -271,6 +297,9 @@ a paragraph`
 <meta http-equiv="X-UA-Compatible" content="IE=edge">
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -294,8 +323,10 @@ a paragraph`
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 
@@ -310,6 +341,9 @@ a paragraph`
 <meta http-equiv="X-UA-Compatible" content="IE=edge">
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -335,8 +369,10 @@ Last updated {{ .LastUpdated }}
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 
@@ -351,6 +387,9 @@ a paragraph`
 <meta http-equiv="X-UA-Compatible" content="IE=edge">
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -372,8 +411,10 @@ a paragraph`
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 
@@ -1,9 +1,12 @@
 package html5_test
 
 import (
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -51,6 +54,425 @@ Last updated {{ .LastUpdated }}
 			}))
 	})
 
+	It("with embedded CSS files", func() {
+		source := ``
+		expectedTmpl := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<link type="text/css" rel="stylesheet" href="https://example.com/style.css">
+<style>
+@font-face { font-family: "Tiny"; src: url("data:font/woff2;base64,d09GMgABAAA=") format("woff2"); }
+body { font-family: "Tiny"; background: url(https://example.com/bg.png); }
+</style>
+<title>Untitled</title>
+</head>
+<body class="article">
+<div id="content">
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{ .LastUpdated }}
+</div>
+</div>
+</body>
+</html>
+`
+		now := time.Now()
+		Expect(RenderHTML(source,
+			configuration.WithHeaderFooter(true),
+			configuration.WithCSS([]string{"https://example.com/style.css", "../../../../test/embed/style.css"}),
+			configuration.WithEmbedAssets(true),
+			configuration.WithLastUpdated(now),
+		)).To(MatchHTMLTemplate(expectedTmpl,
+			struct {
+				LastUpdated string
+			}{
+				LastUpdated: now.Format(configuration.LastUpdatedFormat),
+			}))
+	})
+
+	Context("stylesheets", func() {
+
+		It("with default stylesheet", func() {
+			source := ``
+			expectedTmpl := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<style>
+{{ .CSS }}
+</style>
+<title>Untitled</title>
+</head>
+<body class="article">
+<div id="content">
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{ .LastUpdated }}
+</div>
+</div>
+</body>
+</html>
+`
+			now := time.Now()
+			Expect(RenderHTML(source,
+				configuration.WithHeaderFooter(true),
+				configuration.WithLastUpdated(now),
+			)).To(MatchHTMLTemplate(expectedTmpl,
+				struct {
+					LastUpdated string
+					CSS         string
+				}{
+					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
+				}))
+		})
+
+		It("without stylesheet when unset in header", func() {
+			source := `:stylesheet!:`
+			expectedTmpl := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>Untitled</title>
+</head>
+<body class="article">
+<div id="content">
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{ .LastUpdated }}
+</div>
+</div>
+</body>
+</html>
+`
+			now := time.Now()
+			Expect(RenderHTML(source,
+				configuration.WithHeaderFooter(true),
+				configuration.WithLastUpdated(now),
+			)).To(MatchHTMLTemplate(expectedTmpl,
+				struct {
+					LastUpdated string
+				}{
+					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+				}))
+		})
+
+		It("without stylesheet when unset in configuration", func() {
+			source := `:stylesheet: custom.css`
+			expectedTmpl := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>Untitled</title>
+</head>
+<body class="article">
+<div id="content">
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{ .LastUpdated }}
+</div>
+</div>
+</body>
+</html>
+`
+			now := time.Now()
+			Expect(RenderHTML(source,
+				configuration.WithHeaderFooter(true),
+				configuration.WithAttributeOverride("stylesheet!"),
+				configuration.WithLastUpdated(now),
+			)).To(MatchHTMLTemplate(expectedTmpl,
+				struct {
+					LastUpdated string
+				}{
+					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+				}))
+		})
+
+		It("with linked default stylesheet", func() {
+			source := `:linkcss:`
+			expectedTmpl := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<link type="text/css" rel="stylesheet" href="./libasciidoc.css">
+<title>Untitled</title>
+</head>
+<body class="article">
+<div id="content">
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{ .LastUpdated }}
+</div>
+</div>
+</body>
+</html>
+`
+			now := time.Now()
+			Expect(RenderHTML(source,
+				configuration.WithHeaderFooter(true),
+				configuration.WithLastUpdated(now),
+			)).To(MatchHTMLTemplate(expectedTmpl,
+				struct {
+					LastUpdated string
+				}{
+					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+				}))
+		})
+
+		It("with linked custom stylesheet in styles dir", func() {
+			source := ``
+			expectedTmpl := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<link type="text/css" rel="stylesheet" href="css/custom.css">
+<title>Untitled</title>
+</head>
+<body class="article">
+<div id="content">
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{ .LastUpdated }}
+</div>
+</div>
+</body>
+</html>
+`
+			now := time.Now()
+			Expect(RenderHTML(source,
+				configuration.WithHeaderFooter(true),
+				configuration.WithStylesheet("custom.css"),
+				configuration.WithStylesDir("css/"),
+				configuration.WithLinkCSS(true),
+				configuration.WithLastUpdated(now),
+			)).To(MatchHTMLTemplate(expectedTmpl,
+				struct {
+					LastUpdated string
+				}{
+					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+				}))
+		})
+
+		It("with embedded custom stylesheet", func() {
+			source := `:stylesheet: style.css
+:stylesdir: ../../../../test/embed`
+			expectedTmpl := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<style>
+@font-face { font-family: "Tiny"; src: url("data:font/woff2;base64,d09GMgABAAA=") format("woff2"); }
+body { font-family: "Tiny"; background: url(https://example.com/bg.png); }
+</style>
+<title>Untitled</title>
+</head>
+<body class="article">
+<div id="content">
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{ .LastUpdated }}
+</div>
+</div>
+</body>
+</html>
+`
+			now := time.Now()
+			Expect(RenderHTML(source,
+				configuration.WithHeaderFooter(true),
+				configuration.WithLastUpdated(now),
+			)).To(MatchHTMLTemplate(expectedTmpl,
+				struct {
+					LastUpdated string
+				}{
+					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+				}))
+		})
+
+		It("with custom stylesheet along with css files", func() {
+			source := `:stylesheet: https://example.com/custom.css`
+			expectedTmpl := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<link type="text/css" rel="stylesheet" href="https://example.com/custom.css">
+<link type="text/css" rel="stylesheet" href="/path/to/style.css">
+<title>Untitled</title>
+</head>
+<body class="article">
+<div id="content">
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{ .LastUpdated }}
+</div>
+</div>
+</body>
+</html>
+`
+			now := time.Now()
+			Expect(RenderHTML(source,
+				configuration.WithHeaderFooter(true),
+				configuration.WithCSS([]string{"/path/to/style.css"}),
+				configuration.WithLastUpdated(now),
+			)).To(MatchHTMLTemplate(expectedTmpl,
+				struct {
+					LastUpdated string
+				}{
+					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+				}))
+		})
+
+		It("with copied default stylesheet", func() {
+			dir, err := os.MkdirTemp("", "libasciidoc")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, dir)
+			source := `:linkcss:
+:copycss:
+:stylesdir: css`
+			expectedTmpl := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<link type="text/css" rel="stylesheet" href="css/libasciidoc.css">
+<title>Untitled</title>
+</head>
+<body class="article">
+<div id="content">
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{ .LastUpdated }}
+</div>
+</div>
+</body>
+</html>
+`
+			now := time.Now()
+			Expect(RenderHTML(source,
+				configuration.WithHeaderFooter(true),
+				configuration.WithOutputDir(dir),
+				configuration.WithLastUpdated(now),
+			)).To(MatchHTMLTemplate(expectedTmpl,
+				struct {
+					LastUpdated string
+				}{
+					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+				}))
+			content, err := os.ReadFile(filepath.Join(dir, "css", "libasciidoc.css"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(sgml.DefaultStylesheet + "\n"))
+		})
+
+		Context("safe modes", func() {
+
+			var dir string
+
+			BeforeEach(func() {
+				var err error
+				dir, err = os.MkdirTemp("", "libasciidoc")
+				Expect(err).NotTo(HaveOccurred())
+				DeferCleanup(os.RemoveAll, dir)
+			})
+
+			It("should not copy the stylesheet outside of the output directory in safe mode", func() {
+				source := `:linkcss:
+:copycss:
+:stylesdir: ../victim`
+				diagnostics := types.NewDiagnostics()
+				_, err := RenderHTML(source,
+					configuration.WithHeaderFooter(true),
+					configuration.WithOutputDir(filepath.Join(dir, "out")),
+					configuration.WithSafeMode(configuration.Safe),
+					configuration.WithDiagnostics(diagnostics),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filepath.Join(dir, "victim", "libasciidoc.css")).NotTo(BeAnExistingFile())
+				Expect(diagnostics.All()).To(HaveLen(1))
+				Expect(diagnostics.All()[0].Code).To(Equal(types.RestrictedAccess))
+			})
+
+			It("should ignore the stylesheet attributes of the document in secure mode", func() {
+				source := `:linkcss:
+:copycss:
+:stylesdir: ../victim`
+				result, err := RenderHTML(source,
+					configuration.WithHeaderFooter(true),
+					configuration.WithOutputDir(filepath.Join(dir, "out")),
+					configuration.WithSafeMode(configuration.Secure),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(ContainSubstring(`<link type="text/css" rel="stylesheet" href="./libasciidoc.css">`))
+				Expect(filepath.Join(dir, "victim", "libasciidoc.css")).NotTo(BeAnExistingFile())
+				Expect(filepath.Join(dir, "out", "libasciidoc.css")).NotTo(BeAnExistingFile())
+			})
+
+			It("should ignore the stylesheet attributes of the document in server mode", func() {
+				source := `:linkcss:
+:copycss: test.adoc
+:stylesdir: ../victim
+:stylesheet: owned.txt`
+				result, err := RenderHTML(source,
+					configuration.WithHeaderFooter(true),
+					configuration.WithOutputDir(filepath.Join(dir, "out")),
+					configuration.WithSafeMode(configuration.Server),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(ContainSubstring(`<link type="text/css" rel="stylesheet" href="./libasciidoc.css">`))
+				Expect(filepath.Join(dir, "victim", "owned.txt")).NotTo(BeAnExistingFile())
+				Expect(filepath.Join(dir, "out", "libasciidoc.css")).NotTo(BeAnExistingFile())
+			})
+
+			It("should copy the stylesheet with the attributes of the API in server mode", func() {
+				_, err := RenderHTML(`:stylesdir: ../victim`,
+					configuration.WithHeaderFooter(true),
+					configuration.WithOutputDir(filepath.Join(dir, "out")),
+					configuration.WithSafeMode(configuration.Server),
+					configuration.WithLinkCSS(true),
+					configuration.WithCopyCSS(true),
+					configuration.WithStylesDir("css"),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filepath.Join(dir, "out", "css", "libasciidoc.css")).To(BeAnExistingFile())
+				Expect(filepath.Join(dir, "victim", "libasciidoc.css")).NotTo(BeAnExistingFile())
+			})
+		})
+	})
+
 	It("with quoted text", func() {
 		source := `= The _Document_ *Title*`
 		expectedTmpl := `<!DOCTYPE html>
@@ -201,6 +623,9 @@ Last updated {{ .LastUpdated }}
 <meta http-equiv="X-UA-Compatible" content="IE=edge">
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
+<style>
+{{ .CSS }}
+</style>
 <title>Untitled</title>
 </head>
 <body class="article">
@@ -223,8 +648,10 @@ Last updated {{ .LastUpdated }}
 				configuration.WithLastUpdated(now),
 			)).To(MatchHTMLTemplate(expectedTmpl, struct {
 				LastUpdated string
+				CSS         string
 			}{
 				LastUpdated: now.Format(configuration.LastUpdatedFormat),
+				CSS:         sgml.DefaultStylesheet,
 			}))
 		})
 	})
@@ -583,4 +1010,71 @@ Free use of this software is granted under the terms of the MIT License.</p>
 			}))
 
 	})
+
+	Context("with template dir", func() {
+
+		var templateDir string
+
+		BeforeEach(func() {
+			var err error
+			templateDir, err = os.MkdirTemp("", "libasciidoc-templates")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, templateDir)
+		})
+
+		It("should override paragraph and section title templates", func() {
+			// the trailing newline of the files is removed
+			Expect(os.WriteFile(filepath.Join(templateDir, "Paragraph.tmpl"), []byte(`<p class="house">{{ .Content }}</p>
+
+`), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(templateDir, "SectionTitle.tmpl"), []byte(`<h{{ .LevelPlusOne }} id="{{ toLower .ID }}" class="house">{{ .Content }}</h{{ .LevelPlusOne }}>
+
+`), 0644)).To(Succeed())
+			// ignored files
+			Expect(os.WriteFile(filepath.Join(templateDir, "Unknown.tmpl"), []byte(`unknown`), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(templateDir, "README.md"), []byte(`templates`), 0644)).To(Succeed())
+			source := `== Section
+
+a paragraph
+
+* an item`
+			expected := `<div class="sect1">
+<h2 id="_section" class="house">Section</h2>
+<div class="sectionbody">
+<p class="house">a paragraph</p>
+<div class="ulist">
+<ul>
+<li>
+<p>an item</p>
+</li>
+</ul>
+</div>
+</div>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithTemplateDir(templateDir))).To(MatchHTML(expected))
+		})
+
+		It("should override inline template without its trailing newline", func() {
+			Expect(os.WriteFile(filepath.Join(templateDir, "BoldText.tmpl"), []byte(`<b>{{ .Content }}</b>
+`), 0644)).To(Succeed())
+			source := `a *bold* word`
+			expected := `<div class="paragraph">
+<p>a <b>bold</b> word</p>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithTemplateDir(templateDir))).To(MatchHTML(expected))
+		})
+
+		It("should fail with invalid template", func() {
+			Expect(os.WriteFile(filepath.Join(templateDir, "Paragraph.tmpl"), []byte(`<p>{{ .Content </p>`), 0644)).To(Succeed())
+			_, err := RenderHTML(`a paragraph`, configuration.WithTemplateDir(templateDir))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail with missing template dir", func() {
+			_, err := RenderHTML(`a paragraph`, configuration.WithTemplateDir(filepath.Join(templateDir, "missing")))
+			Expect(err).To(MatchError(ContainSubstring("unable to load templates")))
+		})
+	})
 })
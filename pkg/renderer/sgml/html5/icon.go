@@ -29,5 +29,19 @@ const (
 		`{{ if .Flip }} fa-flip-{{ .Flip }}{{ end }}"` +
 		`{{ if .Title }} title="{{ .Title }}"{{ end }}></i>`
 
+	// SVG icons are rendered with the same classes as font icons, but they embed their glyph so that they
+	// do not need the Font Awesome stylesheet and font
+	iconSVGTmpl = `<svg class="fa` +
+		`{{ if .Admonition }} icon-{{ .Class }}{{ else }} fa-{{ .Class }}{{ end }}` +
+		`{{ if .Size }} fa-{{ .Size }}{{ end }}` +
+		`{{ if .Rotate }} fa-rotate-{{ .Rotate }}{{ end }}` +
+		`{{ if .Flip }} fa-flip-{{ .Flip }}{{ end }}"` +
+		` xmlns="http://www.w3.org/2000/svg" viewBox="{{ .SVG.ViewBox }}"` +
+		`{{ if .SVG.Width }} width="{{ .SVG.Width }}"{{ end }}` +
+		`{{ if .SVG.Height }} height="{{ .SVG.Height }}"{{ end }}` +
+		` fill="currentColor" role="img" aria-label="{{ if .Title }}{{ .Title }}{{ else }}{{ .Alt }}{{ end }}">` +
+		`{{ if .Title }}<title>{{ .Title }}</title>{{ end }}` +
+		`<path transform="{{ .SVG.Transform }}" d="{{ .SVG.Path }}"/></svg>`
+
 	iconTextTmpl = `{{ if .Admonition }}<div class="title">{{ .Alt }}</div>{{ else }}[{{ .Alt }}]{{ end }}`
 )
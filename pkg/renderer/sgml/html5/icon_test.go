@@ -244,6 +244,94 @@ icon:caution[]`
 			})
 		})
 
+		Context("svg icons", func() {
+
+			It("inline icon alone", func() {
+				source := `:icons: svg
+
+icon:stop[]`
+				expected := `<div class="paragraph">
+<p><span class="icon"><svg class="fa fa-stop" xmlns="http://www.w3.org/2000/svg" viewBox="0 -1536 1536 1792" width="0.8571em" height="1em" fill="currentColor" role="img" aria-label="stop"><path transform="scale(1 -1)" d="M1536 1344v-1408q0 -26 -19 -45t-45 -19h-1408q-26 0 -45 19t-19 45v1408q0 26 19 45t45 19h1408q26 0 45 -19t19 -45z"/></svg></span></p>
+</div>
+`
+				Expect(RenderHTML(source)).To(MatchHTML(expected))
+			})
+
+			It("inline icon with roles", func() {
+				source := `:icons: svg
+
+icon:stop[role="red big"]`
+				expected := `<div class="paragraph">
+<p><span class="icon red big"><svg class="fa fa-stop" xmlns="http://www.w3.org/2000/svg" viewBox="0 -1536 1536 1792" width="0.8571em" height="1em" fill="currentColor" role="img" aria-label="stop"><path transform="scale(1 -1)" d="M1536 1344v-1408q0 -26 -19 -45t-45 -19h-1408q-26 0 -45 19t-19 45v1408q0 26 19 45t45 19h1408q26 0 45 -19t19 -45z"/></svg></span></p>
+</div>
+`
+				Expect(RenderHTML(source)).To(MatchHTML(expected))
+			})
+
+			It("inline icon title", func() {
+				source := `:icons: svg
+
+icon:minus[title="Remove"]`
+				expected := `<div class="paragraph">
+<p><span class="icon"><svg class="fa fa-minus" xmlns="http://www.w3.org/2000/svg" viewBox="0 -1536 1408 1792" width="0.7857em" height="1em" fill="currentColor" role="img" aria-label="Remove"><title>Remove</title><path transform="scale(1 -1)" d="M1408 800v-192q0 -40 -28 -68t-68 -28h-1216q-40 0 -68 28t-28 68v192q0 40 28 68t68 28h1216q40 0 68 -28t28 -68z"/></svg></span></p>
+</div>
+`
+				Expect(RenderHTML(source)).To(MatchHTML(expected))
+			})
+
+			It("inline icon size", func() {
+				source := `:icons: svg
+
+icon:stop[2x] icon:stop[fw]`
+				expected := `<div class="paragraph">
+<p><span class="icon"><svg class="fa fa-stop fa-2x" xmlns="http://www.w3.org/2000/svg" viewBox="0 -1536 1536 1792" width="1.7143em" height="2em" fill="currentColor" role="img" aria-label="stop"><path transform="scale(1 -1)" d="M1536 1344v-1408q0 -26 -19 -45t-45 -19h-1408q-26 0 -45 19t-19 45v1408q0 26 19 45t45 19h1408q26 0 45 -19t19 -45z"/></svg></span> <span class="icon"><svg class="fa fa-stop fa-fw" xmlns="http://www.w3.org/2000/svg" viewBox="0 -1536 1536 1792" width="1.2857em" height="1em" fill="currentColor" role="img" aria-label="stop"><path transform="scale(1 -1)" d="M1536 1344v-1408q0 -26 -19 -45t-45 -19h-1408q-26 0 -45 19t-19 45v1408q0 26 19 45t45 19h1408q26 0 45 -19t19 -45z"/></svg></span></p>
+</div>
+`
+				Expect(RenderHTML(source)).To(MatchHTML(expected))
+			})
+
+			It("inline icon rotate and flip", func() {
+				source := `:icons: svg
+
+icon:play[rotate=90,flip=vertical]`
+				expected := `<div class="paragraph">
+<p><span class="icon"><svg class="fa fa-play fa-rotate-90 fa-flip-vertical" xmlns="http://www.w3.org/2000/svg" viewBox="0 -1536 1408 1792" width="0.7857em" height="1em" fill="currentColor" role="img" aria-label="play"><path transform="rotate(90 704 -640) translate(0 -1280) scale(1 -1) scale(1 -1)" d="M1384 609l-1328 -738q-23 -13 -39.5 -3t-16.5 36v1472q0 26 16.5 36t39.5 -3l1328 -738q23 -13 23 -31t-23 -31z"/></svg></span></p>
+</div>
+`
+				Expect(RenderHTML(source)).To(MatchHTML(expected))
+			})
+
+			It("inline icon width and height", func() {
+				source := `:icons: svg
+
+icon:stop[width=20px,height=30px]`
+				expected := `<div class="paragraph">
+<p><span class="icon"><svg class="fa fa-stop" xmlns="http://www.w3.org/2000/svg" viewBox="0 -1536 1536 1792" width="20px" height="30px" fill="currentColor" role="img" aria-label="stop"><path transform="scale(1 -1)" d="M1536 1344v-1408q0 -26 -19 -45t-45 -19h-1408q-26 0 -45 19t-19 45v1408q0 26 19 45t45 19h1408q26 0 45 -19t19 -45z"/></svg></span></p>
+</div>
+`
+				Expect(RenderHTML(source)).To(MatchHTML(expected))
+			})
+
+			It("unknown inline icon", func() {
+				source := `:icons: svg
+
+icon:amazon[link="https://amazon.com"]`
+				expected := `<div class="paragraph">
+<p><span class="icon"><a class="image" href="https://amazon.com">[amazon]</a></span></p>
+</div>
+`
+				Expect(RenderHTML(source)).To(MatchHTML(expected))
+			})
+
+			It("admonition icon", func() {
+				source := `:icons: svg
+
+NOTE: a note`
+				Expect(RenderHTML(source)).To(ContainSubstring(`<td class="icon">
+<svg class="fa icon-note" xmlns="http://www.w3.org/2000/svg" viewBox="0 -1536 1536 1792" width="2.1429em" height="2.5em" fill="currentColor" role="img" aria-label="Note"><title>Note</title><path transform="scale(1 -1)" d="M1024 160v160q0 14 -9 23t-23 9h-96v512`))
+			})
+		})
+
 		Context("icon contexts", func() {
 
 			It("icon in a title", func() {
@@ -3,11 +3,17 @@ package html5
 const (
 	blockImageTmpl = `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="imageblock{{ if .Roles }} {{ .Roles }}{{ end }}">
 <div class="content">
-{{ if ne .Href "" }}<a class="image" href="{{ .Href }}">{{ end }}<img src="{{ .Src }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}>{{ if ne .Href "" }}</a>{{ end }}
+{{ if ne .Href "" }}<a class="image" href="{{ .Href }}">{{ end }}` + svgImageTmpl + `{{ else }}<img src="{{ .Src }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}>{{ end }}{{ if ne .Href "" }}</a>{{ end }}
 </div>{{ if .Title }}
 <div class="title">{{ .Caption }}{{ .Title }}</div>
 {{ else }}
 {{ end }}</div>
 `
-	inlineImageTmpl = `<span class="image{{ if .Roles }} {{ .Roles }}{{ end }}">{{ if ne .Href "" }}<a class="image" href="{{ .Href }}">{{ end }}<img src="{{ .Src }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ if .Title }} title="{{ .Title }}"{{ end }}>{{ if ne .Href "" }}</a>{{ end }}</span>`
+	inlineImageTmpl = `<span class="image{{ if .Roles }} {{ .Roles }}{{ end }}">{{ if ne .Href "" }}<a class="image" href="{{ .Href }}">{{ end }}` + svgImageTmpl + `{{ else }}<img src="{{ .Src }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ if .Title }} title="{{ .Title }}"{{ end }}>{{ end }}{{ if ne .Href "" }}</a>{{ end }}</span>`
+
+	// the inline SVG content or the interactive SVG object, to be followed by an `{{ else }}` branch for the regular image element
+	svgImageTmpl = `{{ if .SVG }}{{ .SVG }}` +
+		`{{ else if .Interactive }}<object type="image/svg+xml" data="{{ .Src }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}>` +
+		`{{ if .Fallback }}<img src="{{ .Fallback }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}>` +
+		`{{ else }}<span class="alt">{{ .Alt }}</span>{{ end }}</object>`
 )
@@ -1,6 +1,8 @@
 package html5_test
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -428,4 +430,237 @@ image::favicon-glasses-16x16.png[Glasses]`
 			// TODO: check that the log/output contains a WARNING message (`image to embed not found or not readable`)
 		})
 	})
+
+	Context("safe modes", func() {
+
+		It("block image within base dir in safe mode", func() {
+			source := `:imagesdir: test/images
+
+image::dot.svg[Dot]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="data:image/svg+xml;base64,PHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciIHZpZXdCb3g9IjAgMCAyIDIiPjxjaXJjbGUgY3g9IjEiIGN5PSIxIiByPSIxIi8+PC9zdmc+Cg==" alt="Dot">
+</div>
+</div>
+`
+			Expect(RenderHTML(source,
+				configuration.WithEmbedAssets(true),
+				configuration.WithBaseDir("../../../.."),
+				configuration.WithSafeMode(configuration.Safe),
+			)).To(MatchHTML(expected))
+		})
+
+		It("block image outside of base dir in safe mode", func() {
+			source := `:imagesdir: ../../../../test/images
+
+image::dot.svg[Dot]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="../../../../test/images/dot.svg" alt="Dot">
+</div>
+</div>
+`
+			diagnostics := types.NewDiagnostics()
+			Expect(RenderHTML(source,
+				configuration.WithEmbedAssets(true),
+				configuration.WithSafeMode(configuration.Safe),
+				configuration.WithDiagnostics(diagnostics),
+			)).To(MatchHTML(expected))
+			Expect(diagnostics.All()).To(HaveLen(1))
+			Expect(diagnostics.All()[0].Code).To(Equal(types.RestrictedAccess))
+			Expect(diagnostics.All()[0].Message).To(HavePrefix("access to '../../../../test/images/dot.svg' is restricted: the file is outside of"))
+		})
+
+		It("inline svg image in secure mode", func() {
+			source := `image::test/images/dot.svg[Dot,opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<span class="alt">Dot</span>
+</div>
+</div>
+`
+			diagnostics := types.NewDiagnostics()
+			Expect(RenderHTML(source,
+				configuration.WithBaseDir("../../../.."),
+				configuration.WithSafeMode(configuration.Secure),
+				configuration.WithDiagnostics(diagnostics),
+			)).To(MatchHTML(expected))
+			Expect(diagnostics.All()).To(HaveLen(1))
+			Expect(diagnostics.All()[0].Code).To(Equal(types.RestrictedAccess))
+			Expect(diagnostics.All()[0].Message).To(Equal("access to '../../../../test/images/dot.svg' is restricted: files cannot be read in the 'secure' safe mode"))
+		})
+	})
+
+	Context("embedded assets", func() {
+
+		It("block svg image", func() {
+			source := `:imagesdir: ../../../../test/images
+
+image::dot.svg[Dot]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="data:image/svg+xml;base64,PHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciIHZpZXdCb3g9IjAgMCAyIDIiPjxjaXJjbGUgY3g9IjEiIGN5PSIxIiByPSIxIi8+PC9zdmc+Cg==" alt="Dot">
+</div>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithEmbedAssets(true))).To(MatchHTML(expected))
+		})
+
+		It("remote block image", func() {
+			source := `image::https://example.com/dot.svg[Dot]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="https://example.com/dot.svg" alt="Dot">
+</div>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithEmbedAssets(true))).To(MatchHTML(expected))
+		})
+
+		It("icon image", func() {
+			source := `:icons: image
+:iconsdir: ../../../../test/images
+:icontype: svg
+
+icon:dot[]`
+			expected := `<div class="paragraph">
+<p><span class="icon"><img src="data:image/svg+xml;base64,PHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciIHZpZXdCb3g9IjAgMCAyIDIiPjxjaXJjbGUgY3g9IjEiIGN5PSIxIiByPSIxIi8+PC9zdmc+Cg==" alt="dot"></span></p>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithEmbedAssets(true))).To(MatchHTML(expected))
+		})
+	})
+
+	Context("svg", func() {
+
+		It("inline block image", func() {
+			source := `:imagesdir: ../../../../test/images
+
+image::circle.svg[Circle,opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<svg xmlns="http://www.w3.org/2000/svg" width="100" height="100" viewBox="0 0 100 100">
+<circle cx="50" cy="50" r="40" fill="red"/>
+</svg>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("inline block image with dimensions", func() {
+			source := `:imagesdir: ../../../../test/images
+
+image::circle.svg[Circle,200,150,opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100" width="200" height="150">
+<circle cx="50" cy="50" r="40" fill="red"/>
+</svg>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("inline block image without attributes on svg element and with dimensions", func() {
+			source := `:imagesdir: ../../../../test/images
+
+image::square.svg[Square,20,20,opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<svg width="20" height="20"><rect width="10" height="10" fill="blue"/></svg>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("inline block image with commented-out svg element before the actual one", func() {
+			source := `:imagesdir: ../../../../test/images
+
+image::commented.svg[Commented,opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+<circle cx="50" cy="50" r="40" fill="green"/>
+</svg>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("inline image in paragraph", func() {
+			source := `:imagesdir: ../../../../test/images
+
+a image:circle.svg[Circle,width=16,opts=inline] circle`
+			expected := `<div class="paragraph">
+<p>a <span class="image"><svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100" width="16">
+<circle cx="50" cy="50" r="40" fill="red"/>
+</svg></span> circle</p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("inline block image not found", func() {
+			source := `image::unknown.svg[Unknown,opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<span class="alt">Unknown</span>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("interactive block image", func() {
+			source := `:imagesdir: images
+
+image::circle.svg[Circle,200,opts=interactive]`
+			expected := `<div class="imageblock">
+<div class="content">
+<object type="image/svg+xml" data="images/circle.svg" width="200"><span class="alt">Circle</span></object>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("interactive block image with fallback", func() {
+			source := `:imagesdir: images
+
+image::circle.svg[Circle,opts=interactive,fallback=circle.png]`
+			expected := `<div class="imageblock">
+<div class="content">
+<object type="image/svg+xml" data="images/circle.svg"><img src="images/circle.png" alt="Circle"></object>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("interactive block image with format", func() {
+			source := `image::circle[Circle,format=svg,opts=interactive]`
+			expected := `<div class="imageblock">
+<div class="content">
+<object type="image/svg+xml" data="circle"><span class="alt">Circle</span></object>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("options ignored on non-svg image", func() {
+			source := `image::circle.png[Circle,opts="inline,interactive"]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="circle.png" alt="Circle">
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
 })
@@ -0,0 +1,19 @@
+package html5
+
+const (
+	indexTermTmpl = "{{ if .ID }}<a id=\"{{ .ID }}\"></a>{{ end }}{{ .Content }}"
+
+	// concealed index terms are not rendered in HTML, except for their anchor when the document has an index
+	concealedIndexTermTmpl = "{{ if .ID }}<a id=\"{{ .ID }}\"></a>{{ end }}"
+
+	indexTmpl = "<div class=\"index\">\n" +
+		"{{ range .Groups }}<div class=\"indexgroup\">\n" +
+		"<h3>{{ .Letter }}</h3>\n" +
+		"<ul>\n{{ .Content }}</ul>\n" +
+		"</div>\n{{ end }}" +
+		"</div>\n"
+
+	indexEntryTmpl = "<li>{{ .Term }}{{ range .Links }}, <a href=\"#{{ .Href }}\">{{ .Label }}</a>{{ end }}\n" +
+		"{{ if .Content }}<ul>\n{{ .Content }}</ul>\n{{ end }}" +
+		"</li>\n"
+)
@@ -75,6 +75,103 @@ discarded.</p>
 </dd>
 </dl>
 </div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("with index section", func() {
+		source := `== Knights
+
+The ((Lancelot)) knight (((Knights, Round Table, Lancelot))) sat at the indexterm2:[Round Table]
+with ((Lancelot)).
+
+[index]
+== Index`
+		expected := `<div class="sect1">
+<h2 id="_knights">Knights</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>The <a id="_indexterm_1"></a>Lancelot knight <a id="_indexterm_2"></a> sat at the <a id="_indexterm_3"></a>Round Table
+with <a id="_indexterm_4"></a>Lancelot.</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_index">Index</h2>
+<div class="sectionbody">
+<div class="index">
+<div class="indexgroup">
+<h3>K</h3>
+<ul>
+<li>Knights
+<ul>
+<li>Round Table
+<ul>
+<li>Lancelot, <a href="#_indexterm_2">1</a>
+</li>
+</ul>
+</li>
+</ul>
+</li>
+</ul>
+</div>
+<div class="indexgroup">
+<h3>L</h3>
+<ul>
+<li>Lancelot, <a href="#_indexterm_1">1</a>, <a href="#_indexterm_4">2</a>
+</li>
+</ul>
+</div>
+<div class="indexgroup">
+<h3>R</h3>
+<ul>
+<li>Round Table, <a href="#_indexterm_3">1</a>
+</li>
+</ul>
+</div>
+</div>
+</div>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("with index section and terms differing by their case or accents", func() {
+		source := `((Zucchini)), ((Apple)), ((Éclair)), ((apple)) and ((eclair)).
+
+[index]
+== Index`
+		expected := `<div class="paragraph">
+<p><a id="_indexterm_1"></a>Zucchini, <a id="_indexterm_2"></a>Apple, <a id="_indexterm_3"></a>Éclair, <a id="_indexterm_4"></a>apple and <a id="_indexterm_5"></a>eclair.</p>
+</div>
+<div class="sect1">
+<h2 id="_index">Index</h2>
+<div class="sectionbody">
+<div class="index">
+<div class="indexgroup">
+<h3>A</h3>
+<ul>
+<li>Apple, <a href="#_indexterm_2">1</a>, <a href="#_indexterm_4">2</a>
+</li>
+</ul>
+</div>
+<div class="indexgroup">
+<h3>E</h3>
+<ul>
+<li>Éclair, <a href="#_indexterm_3">1</a>, <a href="#_indexterm_5">2</a>
+</li>
+</ul>
+</div>
+<div class="indexgroup">
+<h3>Z</h3>
+<ul>
+<li>Zucchini, <a href="#_indexterm_1">1</a>
+</li>
+</ul>
+</div>
+</div>
+</div>
+</div>
 `
 		Expect(RenderHTML(source)).To(MatchHTML(expected))
 	})
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -496,6 +497,9 @@ a link to {scheme}://{path} and https://foo.baz`
 <meta http-equiv="X-UA-Compatible" content="IE=edge">
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
+<style>
+{{ .CSS }}
+</style>
 <title>a title to https://example.com and https://example.com</title>
 </head>
 <body class="article">
@@ -519,8 +523,10 @@ Last updated {{ .LastUpdated }}
 				)).To(MatchHTMLTemplate(expectedTmpl,
 					struct {
 						LastUpdated string
+						CSS         string
 					}{
 						LastUpdated: now.Format(configuration.LastUpdatedFormat),
+						CSS:         sgml.DefaultStylesheet,
 					}))
 			})
 
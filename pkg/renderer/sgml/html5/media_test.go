@@ -0,0 +1,111 @@
+package html5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("videos and audios", func() {
+
+	Context("videos", func() {
+
+		It("without attributes", func() {
+			source := "video::screencast.mp4[]"
+			expected := `<div class="videoblock">
+<div class="content">
+<video src="screencast.mp4" controls>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("with ID, title, poster, dimensions, time range and options", func() {
+			source := `:imagesdir: media
+
+.A screencast
+[#intro.featured]
+video::screencast.mp4[poster.png,640,480,start=10,end=20,opts="autoplay,loop,muted"]`
+			expected := `<div id="intro" class="videoblock featured">
+<div class="title">A screencast</div>
+<div class="content">
+<video src="media/screencast.mp4#t=10,20" width="640" height="480" poster="media/poster.png" autoplay controls loop muted>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("with end only and without controls", func() {
+			source := `video::https://example.com/screencast.webm[end=30,options=nocontrols]`
+			expected := `<div class="videoblock">
+<div class="content">
+<video src="https://example.com/screencast.webm#t=,30">
+Your browser does not support the video tag.
+</video>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("hosted on youtube", func() {
+			source := `video::rPQoq7ThGAU[youtube,640,360,start=30,opts="autoplay,loop,muted,nocontrols"]`
+			expected := `<div class="videoblock">
+<div class="content">
+<iframe width="640" height="360" src="https://www.youtube.com/embed/rPQoq7ThGAU?rel=0&amp;start=30&amp;autoplay=1&amp;loop=1&amp;playlist=rPQoq7ThGAU&amp;mute=1&amp;controls=0" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("hosted on vimeo", func() {
+			source := `video::67480300[vimeo,start=60,opts="autoplay,nofullscreen"]`
+			expected := `<div class="videoblock">
+<div class="content">
+<iframe src="https://player.vimeo.com/video/67480300?autoplay=1#at=60" frameborder="0"></iframe>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("audios", func() {
+
+		It("without attributes", func() {
+			source := "audio::podcast.mp3[]"
+			expected := `<div class="audioblock">
+<div class="content">
+<audio src="podcast.mp3" controls>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("with title, time range and options", func() {
+			source := `.Episode 1
+audio::podcast.mp3[start=60,end=120,opts="autoplay,loop,nocontrols"]`
+			expected := `<div class="audioblock">
+<div class="title">Episode 1</div>
+<div class="content">
+<audio src="podcast.mp3#t=60,120" autoplay loop>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+})
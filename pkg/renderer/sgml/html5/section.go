@@ -2,11 +2,12 @@ package html5
 
 // initializes the sgml
 const (
-	sectionContentTmpl = `<div class="sect{{ .Level }}{{ if .Roles }} {{ .Roles }}{{ end }}">
+	// parts of a book are not wrapped in a `div` element
+	sectionContentTmpl = `{{ if eq .Kind "part" }}{{ .Header }}{{ .Content }}{{ else }}<div class="sect{{ .Level }}{{ if .Roles }} {{ .Roles }}{{ end }}">
 {{ .Header }}{{ if eq .Level 1 }}<div class="sectionbody">
 {{ end }}{{ .Content }}{{ if eq .Level 1 }}</div>
 {{ end }}</div>
-`
-	sectionTitleTmpl = `<h{{ .LevelPlusOne }} id="{{ toLower .ID }}">{{ if .Number }}{{ .Number }}. {{ end }}{{ .Content }}</h{{ .LevelPlusOne }}>
+{{ end }}`
+	sectionTitleTmpl = `<h{{ .LevelPlusOne }} id="{{ toLower .ID }}"{{ if eq .Kind "part" }} class="sect0"{{ end }}>{{ .Caption }}{{ .Content }}</h{{ .LevelPlusOne }}>
 `
 )
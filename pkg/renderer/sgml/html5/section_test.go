@@ -555,6 +555,140 @@ here</p>
 </div>
 </div>
 </div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("in books", func() {
+
+		It("should render parts and number chapters across parts", func() {
+			source := `= Book Title
+:doctype: book
+:sectnums:
+
+= Part One
+
+== Chapter A
+
+=== Section A.1
+
+= Part Two
+
+== Chapter B
+`
+			expected := `<h1 id="_part_one" class="sect0">Part One</h1>
+<div class="sect1">
+<h2 id="_chapter_a">1. Chapter A</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_section_a_1">1.1. Section A.1</h3>
+</div>
+</div>
+</div>
+<h1 id="_part_two" class="sect0">Part Two</h1>
+<div class="sect1">
+<h2 id="_chapter_b">2. Chapter B</h2>
+<div class="sectionbody">
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("should number parts with signifier", func() {
+			source := `= Book Title
+:doctype: book
+:partnums:
+:part-signifier: Part
+:chapter-signifier: Chapter
+:sectnums:
+
+= Basics
+
+== Getting Started
+`
+			expected := `<h1 id="_basics" class="sect0">Part I: Basics</h1>
+<div class="sect1">
+<h2 id="_getting_started">Chapter 1. Getting Started</h2>
+<div class="sectionbody">
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("should render special sections without numbers", func() {
+			source := `= Book Title
+:doctype: book
+:sectnums:
+
+[preface]
+== Preface
+
+=== Audience
+
+== Chapter
+
+[appendix]
+== Tools
+
+=== Hammer
+
+[glossary]
+== Glossary
+
+[colophon]
+== Colophon
+`
+			expected := `<div class="sect1">
+<h2 id="_preface">Preface</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_audience">Audience</h3>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_chapter">1. Chapter</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_tools">Appendix A: Tools</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_hammer">A.1. Hammer</h3>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_glossary">Glossary</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_colophon">Colophon</h2>
+<div class="sectionbody">
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("should render appendix without caption", func() {
+			source := `= Book Title
+:doctype: book
+:appendix-caption!:
+
+[appendix]
+== Tools
+`
+			expected := `<div class="sect1">
+<h2 id="_tools">A. Tools</h2>
+<div class="sectionbody">
+</div>
+</div>
 `
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 		})
@@ -0,0 +1,83 @@
+package html5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("stem", func() {
+
+	Context("inline macros", func() {
+
+		It("with asciimath by default", func() {
+			source := `the equation stem:[sqrt(4) = 2]`
+			expected := `<div class="paragraph">
+<p>the equation <math xmlns="http://www.w3.org/1998/Math/MathML"><mrow><msqrt><mn>4</mn></msqrt><mo>=</mo><mn>2</mn></mrow></math></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("with latexmath set in document attribute", func() {
+			source := `:stem: latexmath
+
+the equation stem:[\frac{a}{b}]`
+			expected := `<div class="paragraph">
+<p>the equation <math xmlns="http://www.w3.org/1998/Math/MathML"><mfrac><mi>a</mi><mi>b</mi></mfrac></math></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("with explicit interpreters", func() {
+			source := `latexmath:[x^2] and asciimath:[a < b]`
+			expected := `<div class="paragraph">
+<p><math xmlns="http://www.w3.org/1998/Math/MathML"><msup><mi>x</mi><mn>2</mn></msup></math> and <math xmlns="http://www.w3.org/1998/Math/MathML"><mrow><mi>a</mi><mo>&lt;</mo><mi>b</mi></mrow></math></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("with latexmath command without argument", func() {
+			source := `latexmath:[\sqrt\limits]`
+			expected := `<div class="paragraph">
+<p><math xmlns="http://www.w3.org/1998/Math/MathML"><msqrt><mrow></mrow></msqrt></math></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("blocks", func() {
+
+		It("as delimited block with id, title and role", func() {
+			source := `[stem#eq1.important]
+.Sum
+++++
+sum_(i=1)^n i
+++++`
+			expected := `<div id="eq1" class="stemblock important">
+<div class="title">Sum</div>
+<div class="content">
+<math xmlns="http://www.w3.org/1998/Math/MathML" display="block"><mrow><munderover><mo>∑</mo><mrow><mi>i</mi><mo>=</mo><mn>1</mn></mrow><mi>n</mi></munderover><mi>i</mi></mrow></math>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("as latexmath paragraph", func() {
+			source := `[latexmath]
+\sqrt[3]{x}`
+			expected := `<div class="stemblock">
+<div class="content">
+<math xmlns="http://www.w3.org/1998/Math/MathML" display="block"><mroot><mi>x</mi><mn>3</mn></mroot></math>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+})
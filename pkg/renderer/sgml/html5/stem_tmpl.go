@@ -0,0 +1,13 @@
+package html5
+
+const (
+	stemBlockTmpl = "<div {{ if .ID }}id=\"{{ .ID }}\" {{ end }}" +
+		"class=\"stemblock{{ if .Roles }} {{ .Roles }}{{ end }}\">\n" +
+		"{{ if .Title }}<div class=\"title\">{{ .Title }}</div>\n{{ end }}" +
+		"<div class=\"content\">\n" +
+		"{{ .Content }}\n" +
+		"</div>\n" +
+		"</div>\n"
+
+	inlineStemTmpl = "{{ .Content }}"
+)
@@ -26,15 +26,17 @@ const (
 
 	tableHeaderTmpl = "{{ if .Content }}<thead>\n<tr>\n{{ .Content }}</tr>\n</thead>\n{{ end }}"
 
-	tableHeaderCellTmpl = "<th class=\"tableblock {{ halign .HAlign }} {{ valign .VAlign }}\">{{ .Content }}</th>\n"
+	tableHeaderCellTmpl = "<th class=\"tableblock {{ halign .HAlign }} {{ valign .VAlign }}\"{{ if gt .ColSpan 1 }} colspan=\"{{ .ColSpan }}\"{{ end }}{{ if gt .RowSpan 1 }} rowspan=\"{{ .RowSpan }}\"{{ end }}>{{ .Content }}</th>\n"
 
 	tableFooterTmpl = "{{ if .Content }}<tfoot>\n<tr>\n{{ .Content }}</tr>\n</tfoot>\n{{ end }}"
 
-	tableFooterCellTmpl = "<td class=\"tableblock {{ halign .HAlign }} {{ valign .VAlign }}\"><p class=\"tableblock\">{{ .Content }}</p></td>\n"
+	tableFooterCellTmpl = "<td class=\"tableblock {{ halign .HAlign }} {{ valign .VAlign }}\"{{ if gt .ColSpan 1 }} colspan=\"{{ .ColSpan }}\"{{ end }}{{ if gt .RowSpan 1 }} rowspan=\"{{ .RowSpan }}\"{{ end }}><p class=\"tableblock\">{{ .Content }}</p></td>\n"
 
 	tableRowTmpl = "<tr>\n{{ .Content }}</tr>\n"
 
-	tableCellTmpl = "<td class=\"tableblock {{ halign .HAlign }} {{ valign .VAlign }}\">{{ .Content }}</td>\n"
+	tableCellTmpl = "<td class=\"tableblock {{ halign .HAlign }} {{ valign .VAlign }}\"{{ if gt .ColSpan 1 }} colspan=\"{{ .ColSpan }}\"{{ end }}{{ if gt .RowSpan 1 }} rowspan=\"{{ .RowSpan }}\"{{ end }}>{{ .Content }}</td>\n"
 
 	tableCellBlockTmpl = "<div class=\"content\">{{ trimLineFeedSuffix .Content }}</div>"
+
+	tableCellLiteralTmpl = "<div class=\"literal\"><pre>{{ .Content }}</pre></div>"
 )
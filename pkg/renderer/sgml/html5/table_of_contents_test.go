@@ -240,6 +240,54 @@ A preamble...
 				Expect(RenderHTML(source)).To(MatchHTML(expected))
 			})
 
+			It("should include parts, chapters and appendices in book", func() {
+				source := `= A title
+:doctype: book
+:toc:
+:sectnums:
+
+[preface]
+== Preface
+
+= Part One
+
+== Chapter A
+
+[appendix]
+== Tools`
+
+				expected := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_preface">Preface</a></li>
+<li><a href="#_part_one">Part One</a>
+<ul class="sectlevel1">
+<li><a href="#_chapter_a">1. Chapter A</a></li>
+<li><a href="#_tools">Appendix A: Tools</a></li>
+</ul>
+</li>
+</ul>
+</div>
+<div class="sect1">
+<h2 id="_preface">Preface</h2>
+<div class="sectionbody">
+</div>
+</div>
+<h1 id="_part_one" class="sect0">Part One</h1>
+<div class="sect1">
+<h2 id="_chapter_a">1. Chapter A</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_tools">Appendix A: Tools</h2>
+<div class="sectionbody">
+</div>
+</div>
+`
+				Expect(RenderHTML(source)).To(MatchHTML(expected))
+			})
+
 			It("should include with custom level", func() {
 				source := `= A title
 :toc:
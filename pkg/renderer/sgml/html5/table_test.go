@@ -752,6 +752,159 @@ image::another-image.png[]
 </tr>
 </tbody>
 </table>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("with comma-separated values and implicit header", func() {
+		source := `,===
+name,description
+
+foo,"a value, with ""quotes"""
+,===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;">
+<col style="width: 50%;">
+</colgroup>
+<thead>
+<tr>
+<th class="tableblock halign-left valign-top">name</th>
+<th class="tableblock halign-left valign-top">description</th>
+</tr>
+</thead>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">foo</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">a value, with "quotes"</p></td>
+</tr>
+</tbody>
+</table>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("with cells spanning columns and rows", func() {
+		source := `|===
+2+| a | b
+.2+| c | d | e
+| f | g
+|===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 33.3333%;">
+<col style="width: 33.3333%;">
+<col style="width: 33.3334%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top" colspan="2"><p class="tableblock">a</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">b</p></td>
+</tr>
+<tr>
+<td class="tableblock halign-left valign-top" rowspan="2"><p class="tableblock">c</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">d</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">e</p></td>
+</tr>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">f</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">g</p></td>
+</tr>
+</tbody>
+</table>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("with cell specifiers for duplication, alignments and styles", func() {
+		source := `[cols="2*"]
+|===
+2*^e| dup
+.>s| strong >.^h| header
+m| mono l| literal  *text*
+|===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;">
+<col style="width: 50%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-center valign-top"><p class="tableblock"><em>dup</em></p></td>
+<td class="tableblock halign-center valign-top"><p class="tableblock"><em>dup</em></p></td>
+</tr>
+<tr>
+<td class="tableblock halign-left valign-bottom"><p class="tableblock"><strong>strong</strong></p></td>
+<th class="tableblock halign-right valign-middle"><p class="tableblock">header</p></th>
+</tr>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock"><code>mono</code></p></td>
+<td class="tableblock halign-left valign-top"><div class="literal"><pre>literal  *text*</pre></div></td>
+</tr>
+</tbody>
+</table>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("with literal cell on multiple lines", func() {
+		source := `[cols="1,1"]
+|===
+| plain
+l| line 1
+  line  2
+|===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;">
+<col style="width: 50%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">plain</p></td>
+<td class="tableblock halign-left valign-top"><div class="literal"><pre>line 1
+  line  2</pre></div></td>
+</tr>
+</tbody>
+</table>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("with nested table in AsciiDoc cell", func() {
+		source := `|===
+| outer a| nested table:
+
+!===
+! nested 1 ! nested 2
+!===
+|===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;">
+<col style="width: 50%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">outer</p></td>
+<td class="tableblock halign-left valign-top"><div class="content"><div class="paragraph">
+<p>nested table:</p>
+</div>
+<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;">
+<col style="width: 50%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">nested 1</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">nested 2</p></td>
+</tr>
+</tbody>
+</table></div></td>
+</tr>
+</tbody>
+</table>
 `
 		Expect(RenderHTML(source)).To(MatchHTML(expected))
 	})
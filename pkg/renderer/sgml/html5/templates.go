@@ -15,11 +15,15 @@ var templates = sgml.Templates{
 	AdmonitionParagraph:          admonitionParagraphTmpl,
 	Article:                      articleTmpl,
 	ArticleHeader:                articleHeaderTmpl,
+	AudioBlock:                   audioBlockTmpl,
+	BibliographyAnchor:           bibliographyAnchorTmpl,
+	BibliographyBlock:            bibliographyBlockTmpl,
 	BlockImage:                   blockImageTmpl,
 	BoldText:                     boldTextTmpl,
 	CalloutList:                  calloutListTmpl,
 	CalloutListElement:           calloutListElementTmpl,
 	CalloutRef:                   calloutRefTmpl,
+	ConcealedIndexTerm:           concealedIndexTermTmpl,
 	DocumentDetails:              documentDetailsTmpl,
 	DocumentAuthorDetails:        documentAuthorDetailsTmpl,
 	EmbeddedParagraph:            embeddedParagraphTmpl,
@@ -32,11 +36,17 @@ var templates = sgml.Templates{
 	Footnotes:                    footnotesTmpl,
 	IconFont:                     iconFontTmpl,
 	IconImage:                    iconImageTmpl,
+	IconSVG:                      iconSVGTmpl,
 	IconText:                     iconTextTmpl,
+	Index:                        indexTmpl,
+	IndexEntry:                   indexEntryTmpl,
+	IndexTerm:                    indexTermTmpl,
 	InlineButton:                 inlineButtonTmpl,
+	InlineCitation:               inlineCitationTmpl,
 	InlineIcon:                   inlineIconTmpl,
 	InlineImage:                  inlineImageTmpl,
 	InlineMenu:                   inlineMenuTmpl,
+	InlineStem:                   inlineStemTmpl,
 	InternalCrossReference:       internalCrossReferenceTmpl,
 	InvalidFootnote:              invalidFootnoteTmpl,
 	ItalicText:                   italicTextTmpl,
@@ -67,12 +77,14 @@ var templates = sgml.Templates{
 	SectionTitle:                 sectionTitleTmpl,
 	SidebarBlock:                 sidebarBlockTmpl,
 	SourceBlock:                  sourceBlockTmpl,
+	StemBlock:                    stemBlockTmpl,
 	SubscriptText:                subscriptTextTmpl,
 	SuperscriptText:              superscriptTextTmpl,
 	Table:                        tableTmpl,
 	TableBody:                    tableBodyTmpl,
 	TableCell:                    tableCellTmpl,
 	TableCellBlock:               tableCellBlockTmpl,
+	TableCellLiteral:             tableCellLiteralTmpl,
 	TableHeader:                  tableHeaderTmpl,
 	TableHeaderCell:              tableHeaderCellTmpl,
 	TableFooter:                  tableFooterTmpl,
@@ -86,4 +98,5 @@ var templates = sgml.Templates{
 	UnorderedListElement:         unorderedListElementTmpl,
 	VerseBlock:                   verseBlockTmpl,
 	VerseParagraph:               verseParagraphTmpl,
+	VideoBlock:                   videoBlockTmpl,
 }
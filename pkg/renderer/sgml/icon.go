@@ -18,6 +18,10 @@ func (r *sgmlRenderer) renderInlineIcon(ctx *context, icon *types.Icon) (string,
 	if err != nil {
 		return "", err
 	}
+	roles, err := r.renderElementRoles(ctx, icon.Attributes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render icon roles")
+	}
 	return r.execute(r.inlineIcon, struct {
 		Class  string
 		Role   string
@@ -31,7 +35,7 @@ func (r *sgmlRenderer) renderInlineIcon(ctx *context, icon *types.Icon) (string,
 		ID:     r.renderElementID(icon.Attributes),
 		Link:   icon.Attributes.GetAsStringWithDefault(types.AttrInlineLink, ""),
 		Window: icon.Attributes.GetAsStringWithDefault(types.AttrImageWindow, ""),
-		Role:   icon.Attributes.GetAsStringWithDefault(types.AttrRoles, ""),
+		Role:   roles,
 	})
 }
 
@@ -48,10 +52,19 @@ func (r *sgmlRenderer) renderIcon(ctx *context, icon types.Icon, admonition bool
 	var tmpl *texttemplate.Template
 	var err error
 	font := false
+	var svg *svgIcon
 	switch icons {
 	case "font":
 		font = true
 		tmpl, err = r.iconFont()
+	case "svg":
+		if svg = newSVGIcon(ctx, icon, admonition); svg != nil {
+			// like font icons, SVG icons of admonitions use the caption as their title
+			font = true
+			tmpl, err = r.iconSVG()
+		} else {
+			tmpl, err = r.iconText()
+		}
 	case "text":
 		tmpl, err = r.iconText()
 	case "image", "":
@@ -97,6 +110,7 @@ func (r *sgmlRenderer) renderIcon(ctx *context, icon types.Icon, admonition bool
 		Height     string
 		Src        string
 		Admonition bool
+		SVG        *svgIcon
 	}{
 		Class:      icon.Class,
 		Alt:        alt,
@@ -108,14 +122,24 @@ func (r *sgmlRenderer) renderIcon(ctx *context, icon types.Icon, admonition bool
 		Flip:       icon.Attributes.GetAsStringWithDefault(types.AttrIconFlip, ""),
 		Link:       icon.Attributes.GetAsStringWithDefault(types.AttrInlineLink, ""),
 		Window:     icon.Attributes.GetAsStringWithDefault(types.AttrImageWindow, ""),
-		Src:        renderIconPath(ctx, icon.Class),
+		Src:        r.getIconSrc(ctx, icon.Class),
 		Admonition: admonition,
+		SVG:        svg,
 	}); err != nil {
 		return "", errors.Wrap(err, "unable to render icon")
 	}
 	return string(s.String()), nil
 }
 
+// returns the location of the icon image, or its content as a `data:` URI if assets are embedded
+func (r *sgmlRenderer) getIconSrc(ctx *context, name string) string {
+	src := renderIconPath(ctx, name)
+	if !embedAssets(ctx) {
+		return src
+	}
+	return dataURI(ctx, src)
+}
+
 func renderIconPath(ctx *context, name string) string {
 	// Icon files by default are in {imagesdir}/icons, where {imagesdir} defaults to "./images"
 	dir := ctx.attributes.GetAsStringWithDefault("iconsdir",
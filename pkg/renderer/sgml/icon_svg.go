@@ -0,0 +1,113 @@
+package sgml
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// svgIconData the glyph of an icon, in the coordinates of the Font Awesome font
+// (ie, with an em size of 1792 units, an ascent of 1536 units and the y axis pointing up)
+type svgIconData struct {
+	width int
+	path  string
+}
+
+const (
+	svgIconEm      = 1792
+	svgIconAscent  = 1536
+	svgIconDescent = svgIconEm - svgIconAscent
+)
+
+// the Font Awesome icons of the admonitions (as in the Asciidoctor stylesheet)
+var admonitionSVGIcons = map[string]string{
+	"note":      "info-circle",
+	"tip":       "lightbulb-o",
+	"warning":   "warning",
+	"caution":   "fire",
+	"important": "exclamation-circle",
+}
+
+// the height (in `em`) of the icons with the `size` attribute (as in the Font Awesome stylesheet)
+var svgIconSizes = map[string]float64{
+	"lg": 1.3333,
+	"2x": 2,
+	"3x": 3,
+	"4x": 4,
+	"5x": 5,
+}
+
+// the width (in `em`) of the icons with the `fw` size (as in the Font Awesome stylesheet)
+const svgIconFixedWidth = 1.2857
+
+// the height (in `em`) of the icons of the admonitions (as in the Asciidoctor stylesheet)
+const admonitionSVGIconSize = 2.5
+
+// svgIcon the data to render an icon as an inline SVG element, which does not need any external asset
+type svgIcon struct {
+	ViewBox   string
+	Path      string
+	Transform string // the transformation of the path, which includes the rotation and the flip of the icon
+	Width     string
+	Height    string
+}
+
+// newSVGIcon returns the SVG of the given icon, or `nil` if there is no such icon in the bundled icons
+func newSVGIcon(ctx *context, icon types.Icon, admonition bool) *svgIcon {
+	name := icon.Class
+	if admonition {
+		name = admonitionSVGIcons[icon.Class]
+	}
+	data, found := svgIcons[name]
+	if !found {
+		log.Warnf("unknown SVG icon: %s", icon.Class)
+		ctx.config.Diagnostics.Warnf(types.UnknownIcon, ctx.position, "unknown SVG icon: %s", icon.Class)
+		return nil
+	}
+	size := 1.0
+	if admonition {
+		size = admonitionSVGIconSize
+	} else if s, found := svgIconSizes[icon.Attributes.GetAsStringWithDefault(types.AttrIconSize, "")]; found {
+		size = s
+	}
+	width := icon.Attributes.GetAsStringWithDefault(types.AttrWidth, "")
+	height := icon.Attributes.GetAsStringWithDefault(types.AttrHeight, "")
+	if width == "" && height == "" {
+		width = formatEm(size * float64(data.width) / svgIconEm)
+		height = formatEm(size)
+		if icon.Attributes.GetAsStringWithDefault(types.AttrIconSize, "") == "fw" {
+			width = formatEm(svgIconFixedWidth)
+		}
+	}
+	// transformations are applied from right to left: the glyph is first turned upside up (since the y axis
+	// of the font points up), then flipped and rotated around the center of the view box
+	cx := strconv.Itoa(data.width / 2)
+	cy := strconv.Itoa((svgIconDescent - svgIconAscent) / 2)
+	transform := []string{}
+	if rotate := icon.Attributes.GetAsStringWithDefault(types.AttrIconRotate, ""); rotate != "" {
+		transform = append(transform, "rotate("+rotate+" "+cx+" "+cy+")")
+	}
+	switch icon.Attributes.GetAsStringWithDefault(types.AttrIconFlip, "") {
+	case "horizontal":
+		transform = append(transform, "translate("+strconv.Itoa(data.width)+" 0) scale(-1 1)")
+	case "vertical":
+		transform = append(transform, "translate(0 "+strconv.Itoa(svgIconDescent-svgIconAscent)+") scale(1 -1)")
+	}
+	transform = append(transform, "scale(1 -1)")
+	return &svgIcon{
+		ViewBox:   "0 " + strconv.Itoa(-svgIconAscent) + " " + strconv.Itoa(data.width) + " " + strconv.Itoa(svgIconEm),
+		Path:      data.path,
+		Transform: strings.Join(transform, " "),
+		Width:     width,
+		Height:    height,
+	}
+}
+
+// formats the given value in `em`, with at most 4 decimals
+func formatEm(v float64) string {
+	return strconv.FormatFloat(math.Round(v*10000)/10000, 'f', -1, 64) + "em"
+}
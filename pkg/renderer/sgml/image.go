@@ -1,10 +1,10 @@
 package sgml
 
 import (
-	"encoding/base64"
 	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -50,6 +50,7 @@ func (r *sgmlRenderer) renderImageBlock(ctx *context, img *types.ImageBlock) (st
 	if err != nil {
 		return "", errors.Wrap(err, "unable to render image")
 	}
+	svg := r.getSVGImage(ctx, img.Location, img.Attributes, alt)
 	return r.execute(r.blockImage, struct {
 		ID          string
 		Src         string
@@ -61,6 +62,9 @@ func (r *sgmlRenderer) renderImageBlock(ctx *context, img *types.ImageBlock) (st
 		Alt         string
 		Width       string
 		Height      string
+		SVG         string
+		Interactive bool
+		Fallback    string
 	}{
 		ID:          r.renderElementID(img.Attributes),
 		Src:         src,
@@ -72,6 +76,9 @@ func (r *sgmlRenderer) renderImageBlock(ctx *context, img *types.ImageBlock) (st
 		Alt:         alt,
 		Width:       img.Attributes.GetAsStringWithDefault(types.AttrWidth, ""),
 		Height:      img.Attributes.GetAsStringWithDefault(types.AttrHeight, ""),
+		SVG:         svg.content,
+		Interactive: svg.interactive,
+		Fallback:    svg.fallback,
 	})
 }
 
@@ -90,22 +97,29 @@ func (r *sgmlRenderer) renderInlineImage(ctx *context, img *types.InlineImage) (
 	if err != nil {
 		return "", errors.Wrap(err, "unable to render inline image roles")
 	}
+	svg := r.getSVGImage(ctx, img.Location, img.Attributes, alt)
 	return r.execute(r.inlineImage, struct {
-		Src    string
-		Roles  string
-		Title  string
-		Href   string
-		Alt    string
-		Width  string
-		Height string
+		Src         string
+		Roles       string
+		Title       string
+		Href        string
+		Alt         string
+		Width       string
+		Height      string
+		SVG         string
+		Interactive bool
+		Fallback    string
 	}{
-		Src:    src,
-		Title:  title,
-		Roles:  roles,
-		Href:   href,
-		Alt:    alt,
-		Width:  img.Attributes.GetAsStringWithDefault(types.AttrWidth, ""),
-		Height: img.Attributes.GetAsStringWithDefault(types.AttrHeight, ""),
+		Src:         src,
+		Title:       title,
+		Roles:       roles,
+		Href:        href,
+		Alt:         alt,
+		Width:       img.Attributes.GetAsStringWithDefault(types.AttrWidth, ""),
+		Height:      img.Attributes.GetAsStringWithDefault(types.AttrHeight, ""),
+		SVG:         svg.content,
+		Interactive: svg.interactive,
+		Fallback:    svg.fallback,
 	})
 }
 
@@ -115,20 +129,11 @@ func (r *sgmlRenderer) getImageSrc(ctx *context, location *types.Location) strin
 	}
 	src := location.ToString()
 
-	// if Data URI is enables, then include the content of the file in the `src` attribute of the `<img>` tag
-	if !ctx.attributes.Has("data-uri") {
+	// if Data URI is enabled, then include the content of the file in the `src` attribute of the `<img>` tag
+	if !embedAssets(ctx) {
 		return src
 	}
-	dir := filepath.Dir(ctx.config.Filename)
-	src = filepath.Join(dir, src)
-	result := "data:image/" + strings.TrimPrefix(filepath.Ext(src), ".") + ";base64,"
-	data, err := os.ReadFile(src)
-	if err != nil {
-		log.Warnf("image to embed not found or not readable: %s", src)
-		return result
-	}
-	result += base64.StdEncoding.EncodeToString(data)
-	return result
+	return dataURI(ctx, src)
 }
 
 func (r *sgmlRenderer) renderImageAlt(attrs types.Attributes, path string) (string, error) {
@@ -146,3 +151,100 @@ func (r *sgmlRenderer) renderImageAlt(attrs types.Attributes, path string) (stri
 	result = strings.ReplaceAll(result, "_", " ")
 	return result, nil
 }
+
+// svgImage the rendering options of an SVG image
+type svgImage struct {
+	content     string // the sanitized `<svg>` markup of an image with the `inline` option
+	interactive bool   // true if the image has the `interactive` option
+	fallback    string // the location of the image to display if the interactive SVG image cannot be
+}
+
+// getSVGImage returns the inline content or the interactive options of the image at the given location
+// (which must have been prefixed with the `imagesdir` already), if it is an SVG image with the `inline`
+// or `interactive` option. Otherwise, the image is rendered in a regular `<img>` element.
+func (r *sgmlRenderer) getSVGImage(ctx *context, location *types.Location, attrs types.Attributes, alt string) svgImage {
+	path := location.ToString()
+	if attrs.GetAsStringWithDefault(types.AttrImageFormat, "") != "svg" && !strings.EqualFold(filepath.Ext(path), ".svg") {
+		return svgImage{}
+	}
+	switch {
+	case attrs.HasOption(types.AttrImageInline):
+		if location.Scheme != "" {
+			log.Warnf("unable to inline remote SVG image: %s", path)
+			ctx.config.Diagnostics.Warnf(types.MissingImage, ctx.position, "unable to inline remote SVG image: %s", path)
+			return svgImage{
+				content: `<span class="alt">` + alt + `</span>`,
+			}
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(baseDir(ctx), path)
+		}
+		if !canRead(ctx, path) {
+			return svgImage{
+				content: `<span class="alt">` + alt + `</span>`,
+			}
+		}
+		content, err := readSVG(path, attrs.GetAsStringWithDefault(types.AttrWidth, ""), attrs.GetAsStringWithDefault(types.AttrHeight, ""))
+		if err != nil {
+			log.Warnf("SVG image to inline not found or not readable: %s", path)
+			ctx.config.Diagnostics.Warnf(types.MissingImage, ctx.position, "SVG image to inline not found or not readable: %s", path)
+			return svgImage{
+				content: `<span class="alt">` + alt + `</span>`,
+			}
+		}
+		return svgImage{
+			content: content,
+		}
+	case attrs.HasOption(types.AttrImageInteractive):
+		result := svgImage{
+			interactive: true,
+		}
+		if fallback, found := attrs.GetAsString(types.AttrImageFallback); found && fallback != "" {
+			result.fallback = r.getImageSrc(ctx, &types.Location{Path: fallback})
+		}
+		return result
+	default:
+		return svgImage{}
+	}
+}
+
+var (
+	svgPrologRx         = regexp.MustCompile(`^(?:\s+|<\?[\s\S]*?\?>|<!--[\s\S]*?-->|<!DOCTYPE(?:[^>\[]|\[[\s\S]*?\])*>)*`)
+	svgStartTagRx       = regexp.MustCompile(`<svg(?:\s[^>]*)?>`)
+	svgDimensionAttrRx  = regexp.MustCompile(`\s(?:width|height|style)=(?:"[^"]*"|'[^']*')`)
+	svgTrailingSpacesRx = regexp.MustCompile(`\s*(/?>)$`)
+)
+
+// readSVG reads the SVG file at the given path and returns its `<svg>` element, without the XML prolog,
+// the DOCTYPE and comments which may precede it. If a width or height is given, it replaces the
+// dimensions (and style) of the `<svg>` element
+func readSVG(path, width, height string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	// skip the XML prolog, the DOCTYPE and the comments, which may contain a (commented-out) `<svg>` element
+	content := strings.TrimPrefix(string(data), "\ufeff")
+	content = content[len(svgPrologRx.FindString(content)):]
+	loc := svgStartTagRx.FindStringIndex(content)
+	if loc == nil {
+		return "", errors.Errorf("no '<svg>' element in '%s'", path)
+	}
+	startTag := content[loc[0]:loc[1]]
+	content = content[loc[1]:]
+	if end := strings.LastIndex(content, "</svg>"); end >= 0 {
+		content = content[:end+len("</svg>")]
+	}
+	if width != "" || height != "" {
+		startTag = svgDimensionAttrRx.ReplaceAllString(startTag, "")
+		dimensions := ""
+		if width != "" {
+			dimensions += ` width="` + width + `"`
+		}
+		if height != "" {
+			dimensions += ` height="` + height + `"`
+		}
+		startTag = svgTrailingSpacesRx.ReplaceAllString(startTag, dimensions+"$1")
+	}
+	return startTag + content, nil
+}
@@ -1,13 +1,153 @@
 package sgml
 
 import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
 	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
 )
 
 func (r *sgmlRenderer) renderIndexTerm(ctx *context, t *types.IndexTerm) (string, error) {
-	return r.renderInlineElements(ctx, t.Term)
+	content, err := r.renderInlineElements(ctx, t.Term)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render index term")
+	}
+	return r.execute(r.indexTerm, struct {
+		Context *context
+		ID      string
+		Content string
+	}{
+		Context: ctx,
+		ID:      indexTermID(ctx, t.ID),
+		Content: content,
+	})
+}
+
+func (r *sgmlRenderer) renderConcealedIndexTerm(ctx *context, t *types.ConcealedIndexTerm) (string, error) {
+	return r.execute(r.concealedIndexTerm, struct {
+		Context *context
+		ID      string
+		Term1   string
+		Term2   string
+		Term3   string
+	}{
+		Context: ctx,
+		ID:      indexTermID(ctx, t.ID),
+		Term1:   indexTermToString(t.Term1),
+		Term2:   indexTermToString(t.Term2),
+		Term3:   indexTermToString(t.Term3),
+	})
+}
+
+// the anchors of the index terms are only needed when the document has an index section
+func indexTermID(ctx *context, id string) string {
+	if !ctx.hasIndexSection {
+		return ""
+	}
+	return id
+}
+
+func indexTermToString(term interface{}) string {
+	if term, ok := term.(string); ok {
+		return term
+	}
+	return ""
+}
+
+// hasIndexSection returns `true` if one of the given elements is an `[index]` section (or contains one)
+func hasIndexSection(elements []interface{}) bool {
+	for _, e := range elements {
+		if s, ok := e.(*types.Section); ok {
+			if s.GetStyle() == types.IndexSection || hasIndexSection(s.Elements) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type indexGroup struct {
+	Letter  string
+	Content string
+}
+
+// renderIndex renders the entries of the index, grouped by their first letter
+func (r *sgmlRenderer) renderIndex(ctx *context) (string, error) {
+	if ctx.index == nil {
+		return "", nil
+	}
+	groups := []*indexGroup{}
+	var group *indexGroup
+	content := &strings.Builder{}
+	for _, e := range ctx.index.Entries {
+		first, _ := utf8.DecodeRuneInString(types.FoldIndexTerm(e.Term))
+		letter := string(unicode.ToUpper(first))
+		if group == nil || group.Letter != letter {
+			if group != nil {
+				group.Content = content.String()
+				content.Reset()
+			}
+			group = &indexGroup{
+				Letter: letter,
+			}
+			groups = append(groups, group)
+		}
+		entry, err := r.renderIndexEntry(ctx, e)
+		if err != nil {
+			return "", err
+		}
+		content.WriteString(entry)
+	}
+	if group != nil {
+		group.Content = content.String()
+	}
+	return r.execute(r.index, struct {
+		Context *context
+		Groups  []*indexGroup
+	}{
+		Context: ctx,
+		Groups:  groups,
+	})
+}
+
+type indexLink struct {
+	Href  string
+	Label string
 }
 
-func (r *sgmlRenderer) renderConcealedIndexTerm(_ *types.ConcealedIndexTerm) (string, error) {
-	return "", nil // do not render in SGML
+func (r *sgmlRenderer) renderIndexEntry(ctx *context, e *types.IndexEntry) (string, error) {
+	content := &strings.Builder{}
+	for _, sub := range e.Entries {
+		s, err := r.renderIndexEntry(ctx, sub)
+		if err != nil {
+			return "", err
+		}
+		content.WriteString(s)
+	}
+	// occurrences are numbered, in the order in which they appear in the document
+	links := make([]*indexLink, len(e.Anchors))
+	for i, a := range e.Anchors {
+		links[i] = &indexLink{
+			Href:  a,
+			Label: strconv.Itoa(i + 1),
+		}
+	}
+	result, err := r.execute(r.indexEntry, struct {
+		Context *context
+		Term    string
+		Links   []*indexLink
+		Content string
+	}{
+		Context: ctx,
+		Term:    escapeString(e.Term),
+		Links:   links,
+		Content: content.String(),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render index entry")
+	}
+	return result, nil
 }
@@ -3,6 +3,7 @@ package sgml
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	texttemplate "text/template"
 
@@ -30,15 +31,16 @@ func (r *sgmlRenderer) renderList(ctx *context, l *types.List) (string, error) {
 // -------------------------------------------------------
 func (r *sgmlRenderer) renderOrderedList(ctx *context, l *types.List) (string, error) {
 	content := &strings.Builder{}
-
+	number, step := orderedListNumbering(l)
 	for _, element := range l.Elements {
 		e, ok := element.(*types.OrderedListElement)
 		if !ok {
 			return "", errors.Errorf("unable to render ordered list element of type '%T'", element)
 		}
-		if err := r.renderOrderedListElement(ctx, content, e); err != nil {
+		if err := r.renderOrderedListElement(ctx, content, e, number); err != nil {
 			return "", errors.Wrap(err, "unable to render ordered list")
 		}
+		number += step
 	}
 	roles, err := r.renderElementRoles(ctx, l.Attributes)
 	if err != nil {
@@ -85,6 +87,21 @@ func getNumberingStyle(l *types.List) (string, error) {
 	return e.Style, nil
 }
 
+// returns the number of the first element of the given list, along with
+// the increment to apply to get the number of the next element
+func orderedListNumbering(l *types.List) (int, int) {
+	step := 1
+	start := 1
+	if l.Attributes.HasOption("reversed") {
+		step = -1
+		start = len(l.Elements)
+	}
+	if s, err := strconv.Atoi(l.Attributes.GetAsStringWithDefault(types.AttrStart, "")); err == nil {
+		start = s
+	}
+	return start, step
+}
+
 // this numbering style is only really relevant to HTML
 func (r *sgmlRenderer) numberingType(style string) string {
 	switch style {
@@ -101,7 +118,7 @@ func (r *sgmlRenderer) numberingType(style string) string {
 	}
 }
 
-func (r *sgmlRenderer) renderOrderedListElement(ctx *context, w io.Writer, element *types.OrderedListElement) error {
+func (r *sgmlRenderer) renderOrderedListElement(ctx *context, w io.Writer, element *types.OrderedListElement, number int) error {
 	content, err := r.renderListElements(ctx, element.GetElements())
 	if err != nil {
 		return errors.Wrap(err, "unable to render ordered list element content")
@@ -112,9 +129,11 @@ func (r *sgmlRenderer) renderOrderedListElement(ctx *context, w io.Writer, eleme
 	}
 	if err = tmpl.Execute(w, struct {
 		Context *context
+		Number  int
 		Content string
 	}{
 		Context: ctx,
+		Number:  number,
 		Content: string(content),
 	}); err != nil {
 		return errors.Wrap(err, "unable to render ordered list element")
@@ -152,6 +171,10 @@ func (r *sgmlRenderer) renderUnorderedList(ctx *context, l *types.List) (string,
 	if err != nil {
 		return "", errors.Wrap(err, "unable to render callout list roles")
 	}
+	style := r.renderElementStyle(l.Attributes)
+	if style == "" && ctx.withinBibliography {
+		style = types.BibliographySection
+	}
 	return r.execute(r.unorderedList, struct {
 		Context   *context
 		ID        string
@@ -169,7 +192,7 @@ func (r *sgmlRenderer) renderUnorderedList(ctx *context, l *types.List) (string,
 		Items:     l.Elements,
 		Content:   content.String(),
 		Roles:     roles,
-		Style:     r.renderElementStyle(l.Attributes),
+		Style:     style,
 	})
 }
 func (r *sgmlRenderer) renderUnorderedListElement(ctx *context, w io.Writer, element types.ListElement) error {
@@ -308,13 +331,14 @@ func (r *sgmlRenderer) renderLabeledListItem(ctx *context, tmpl *texttemplate.Te
 // Callout Lists
 // -------------------------------------------------------
 func (r *sgmlRenderer) renderCalloutList(ctx *context, l *types.List) (string, error) {
+	number := ctx.GetAndIncrementCalloutListCounter()
 	content := &strings.Builder{}
 	for _, element := range l.Elements {
 		e, ok := element.(*types.CalloutListElement)
 		if !ok {
 			return "", errors.Errorf("unable to render callout list element of type '%T'", element)
 		}
-		rendererElement, err := r.renderCalloutListElement(ctx, e)
+		rendererElement, err := r.renderCalloutListElement(ctx, e, number)
 		if err != nil {
 			return "", errors.Wrap(err, "unable to render callout list element")
 		}
@@ -329,34 +353,38 @@ func (r *sgmlRenderer) renderCalloutList(ctx *context, l *types.List) (string, e
 		return "", errors.Wrap(err, "unable to render callout list roles")
 	}
 	return r.execute(r.calloutList, struct {
-		Context *context
-		ID      string
-		Title   string
-		Roles   string
-		Content string
-		Items   []types.ListElement
+		Context    *context
+		ID         string
+		Title      string
+		Roles      string
+		ListNumber int
+		Content    string
+		Items      []types.ListElement
 	}{
-		Context: ctx,
-		ID:      r.renderElementID(l.Attributes),
-		Title:   title,
-		Roles:   roles,
-		Content: content.String(),
-		Items:   l.Elements,
+		Context:    ctx,
+		ID:         r.renderElementID(l.Attributes),
+		Title:      title,
+		Roles:      roles,
+		ListNumber: number,
+		Content:    content.String(),
+		Items:      l.Elements,
 	})
 }
 
-func (r *sgmlRenderer) renderCalloutListElement(ctx *context, element *types.CalloutListElement) (string, error) {
+func (r *sgmlRenderer) renderCalloutListElement(ctx *context, element *types.CalloutListElement, number int) (string, error) {
 	content, err := r.renderListElements(ctx, element.Elements)
 	if err != nil {
 		return "", errors.Wrap(err, "unable to render callout list element content")
 	}
 	return r.execute(r.calloutListElement, struct {
-		Context *context
-		Ref     int
-		Content string
+		Context    *context
+		Ref        int
+		ListNumber int
+		Content    string
 	}{
-		Context: ctx,
-		Ref:     element.Ref,
-		Content: string(content),
+		Context:    ctx,
+		Ref:        element.Ref,
+		ListNumber: number,
+		Content:    string(content),
 	})
 }
@@ -0,0 +1,27 @@
+package manpage
+
+const (
+	// the `.TH` macro is rendered from the `mantitle`, `manvolnum`, `mansource` and `manmanual` attributes,
+	// and the date of the document revision (or the date of the last update)
+	articleTmpl = `'\" t
+{{ $date := slice .LastUpdated 0 10 }}{{ with index .Attributes "revdate" }}{{ $date = . }}{{ end }}
+.\"     Title: {{ index .Attributes "mantitle" }}
+.\"    Author: {{ .Authors }}
+.\" Generator: {{ .Generator }}
+.\"      Date: {{ $date }}
+.\"    Manual: {{ index .Attributes "manmanual" }}
+.\"    Source: {{ index .Attributes "mansource" }}
+.\"  Language: English
+.\"
+.TH "{{ index .Attributes "mantitle" | toUpper }}" "{{ index .Attributes "manvolnum" }}" "{{ $date }}" "{{ index .Attributes "mansource" }}" "{{ index .Attributes "manmanual" }}"
+.nh
+.ad l
+{{ .Header }}{{ .Content }}
+{{ if .Authors }}.SH "AUTHOR(S)"
+.sp
+\fB{{ .Authors }}\fP
+{{ end }}`
+
+	// the document title is rendered in the `.TH` macro
+	articleHeaderTmpl = "{{- /* document title is rendered in the .TH macro */ -}}"
+)
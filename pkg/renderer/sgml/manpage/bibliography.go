@@ -0,0 +1,14 @@
+package manpage
+
+const (
+	bibliographyAnchorTmpl = `[{{ .Label }}]`
+
+	inlineCitationTmpl = `{{ .Content }}`
+
+	bibliographyBlockTmpl = `{{ if .Title }}.sp
+.B {{ .Title }}
+.br
+{{ end }}{{ range .Entries }}.sp
+{{ if .Label }}{{ .Label }} {{ end }}{{ .Content }}
+{{ end }}`
+)
@@ -0,0 +1,7 @@
+package manpage
+
+const (
+	lineBreakTmpl = `
+.br
+`
+)
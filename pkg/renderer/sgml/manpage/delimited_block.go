@@ -0,0 +1,71 @@
+package manpage
+
+const (
+	admonitionBlockTmpl = `.sp
+.RS 4
+.B {{ .Icon }}{{ if .Title }}: {{ .Title }}{{ end }}
+.br
+{{ .Content }}.RE
+`
+
+	exampleBlockTmpl = `.sp
+{{ if .Title }}.B {{ .Title }}
+.br
+{{ end }}.RS 4
+{{ .Content }}.RE
+`
+
+	sidebarBlockTmpl = `.sp
+{{ if .Title }}.B {{ .Title }}
+.br
+{{ end }}.RS 4
+{{ .Content }}.RE
+`
+
+	quoteBlockTmpl = `.sp
+{{ if .Title }}.B {{ .Title }}
+.br
+{{ end }}.RS 4
+{{ .Content }}.RE
+{{ if .Attribution.First }}.RS 4
+\(em {{ .Attribution.First }}{{ if .Attribution.Second }}, \fI{{ .Attribution.Second }}\fP{{ end }}
+.RE
+{{ end }}`
+
+	markdownQuoteBlockTmpl = quoteBlockTmpl
+
+	verseBlockTmpl = `.sp
+{{ if .Title }}.B {{ .Title }}
+.br
+{{ end }}.RS 4
+.nf
+{{ .Content }}
+.fi
+.RE
+{{ if .Attribution.First }}.RS 4
+\(em {{ .Attribution.First }}{{ if .Attribution.Second }}, \fI{{ .Attribution.Second }}\fP{{ end }}
+.RE
+{{ end }}`
+
+	// listing, literal, source and fenced blocks are rendered in a monospaced font, without filling
+	verbatimBlockTmpl = `.sp
+{{ if .Title }}.B {{ .Title }}
+.br
+{{ end }}.if n .RS 4
+.nf
+.fam C
+{{ .Content }}
+.fam
+.fi
+.if n .RE
+`
+	listingBlockTmpl = verbatimBlockTmpl
+	literalBlockTmpl = verbatimBlockTmpl
+	sourceBlockTmpl  = verbatimBlockTmpl
+	fencedBlockTmpl  = verbatimBlockTmpl
+
+	openBlockTmpl = "{{ .Content }}"
+
+	// the name here is weird because "pass" as a prefix triggers a false security warning
+	passthroughBlock = "{{ .Content }}\n" //nolint:gosec // avoids a Gosec false positive because the const name starts with 'pass'
+)
@@ -0,0 +1,79 @@
+package manpage_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("delimited blocks", func() {
+
+	It("listing block with callouts", func() {
+		source := `----
+$ foo --bar <1>
+----
+<1> the bar option`
+		expected := `.sp
+.if n .RS 4
+.nf
+.fam C
+$ foo \-\-bar \fB(1)\fP
+.fam
+.fi
+.if n .RE
+.RS 4
+.IP \fB(1)\fP 4
+the bar option
+.RE
+`
+		Expect(RenderManpage(source)).To(MatchHTML(expected))
+	})
+
+	It("admonition block", func() {
+		source := `[WARNING]
+====
+watch out!
+====`
+		expected := `.sp
+.RS 4
+.B Warning
+.br
+watch out!
+.RE
+`
+		Expect(RenderManpage(source)).To(MatchHTML(expected))
+	})
+
+	It("table with header", func() {
+		source := `[options="header"]
+|===
+|Option |Description
+|-f |the foo option
+|===`
+		expected := ".sp\n" +
+			".TS\n" +
+			"allbox tab(\t);\n" +
+			"ltB ltB\n" +
+			"lt lt.\n" +
+			"T{\nOption\nT}\tT{\nDescription\nT}\n" +
+			"T{\n\\-f\nT}\tT{\nthe foo option\nT}\n" +
+			".TE\n"
+		Expect(RenderManpage(source)).To(MatchHTML(expected))
+	})
+
+	It("stem block", func() {
+		source := `[latexmath]
+++++
+\frac{a}{b}
+++++`
+		expected := `.sp
+.RS 4
+.nf
+\(rsfrac{a}{b}
+.fi
+.RE
+`
+		Expect(RenderManpage(source)).To(MatchHTML(expected))
+	})
+})
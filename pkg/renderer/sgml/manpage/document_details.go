@@ -0,0 +1,7 @@
+package manpage
+
+const (
+	// the authors are rendered in the `AUTHOR(S)` section at the end of the document
+	documentDetailsTmpl       = "{{- /* authors are rendered at the end of the document */ -}}"
+	documentAuthorDetailsTmpl = "{{- /* authors are rendered at the end of the document */ -}}"
+)
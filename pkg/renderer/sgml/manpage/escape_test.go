@@ -0,0 +1,44 @@
+package manpage_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("escaping", func() {
+
+	It("quoted text and special characters", func() {
+		source := `*bold*, _italic_, ` + "`mono`" + `, a back\slash, a 'quote', a -- dash & (C) <tags>`
+		expected := `.sp
+\fBbold\fP, \fIitalic\fP, \f(CRmono\fP, a back\(rsslash, a \(aqquote\(aq, a \(em dash & \(co <tags>
+`
+		Expect(RenderManpage(source)).To(MatchHTML(expected))
+	})
+
+	It("leading periods in content", func() {
+		source := `----
+.foo
+ .bar
+----`
+		expected := `.sp
+.if n .RS 4
+.nf
+.fam C
+\&.foo
+ .bar
+.fam
+.fi
+.if n .RE
+`
+		Expect(RenderManpage(source)).To(MatchHTML(expected))
+	})
+
+	It("section title with quotes", func() {
+		source := `== The "foo" option`
+		expected := `.SH "THE \(dqFOO\(dq OPTION"
+`
+		Expect(RenderManpage(source)).To(MatchHTML(expected))
+	})
+})
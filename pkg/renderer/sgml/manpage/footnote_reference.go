@@ -0,0 +1,14 @@
+package manpage
+
+const (
+	footnoteTmpl        = `[{{ .ID }}]`
+	footnoteRefTmpl     = `[{{ .ID }}]`
+	invalidFootnoteTmpl = `[{{ .Ref }}]`
+
+	footnotesTmpl = `.SH "NOTES"
+{{ .Content }}`
+
+	footnoteElementTmpl = `.IP "[{{ .ID }}]" 4
+{{ .Content }}
+`
+)
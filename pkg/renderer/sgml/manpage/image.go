@@ -0,0 +1,17 @@
+package manpage
+
+const (
+	// images can't be displayed in man pages, so only their alternate text is rendered
+	blockImageTmpl = `.sp
+{{ if .Title }}.B {{ .Caption }}{{ .Title }}
+.br
+{{ end }}[{{ .Alt }}]
+`
+	inlineImageTmpl = `[{{ .Alt }}]`
+
+	inlineIconTmpl = `{{ .Icon }}`
+	iconImageTmpl  = `[{{ .Alt }}]`
+	iconFontTmpl   = `[{{ if .Title }}{{ .Title }}{{ else }}{{ .Alt }}{{ end }}]`
+	iconSVGTmpl    = iconFontTmpl
+	iconTextTmpl   = `{{ .Alt }}`
+)
@@ -0,0 +1,24 @@
+package manpage
+
+const (
+	boldTextTmpl        = `\fB{{ .Content }}\fP`
+	italicTextTmpl      = `\fI{{ .Content }}\fP`
+	monospaceTextTmpl   = `\f(CR{{ .Content }}\fP`
+	subscriptTextTmpl   = `~{{ .Content }}~`
+	superscriptTextTmpl = `^{{ .Content }}^`
+	markedTextTmpl      = `{{ .Content }}`
+
+	linkTmpl = `{{ if eq .Text .URL }}\fI{{ .URL }}\fP{{ else }}{{ .Text }} <\fI{{ .URL }}\fP>{{ end }}`
+
+	internalCrossReferenceTmpl = `{{ .Label }}`
+	externalCrossReferenceTmpl = `{{ .Label }}`
+
+	inlineButtonTmpl = `\fB[{{ . }}]\fP`
+
+	inlineMenuTmpl = `{{ range $index, $element := .Path }}{{ if $index }}\ \(->\ {{ end }}\fI{{ $element }}\fP{{ end }}`
+
+	indexTermTmpl          = "{{ .Content }}"
+	concealedIndexTermTmpl = "{{- /* concealed index terms are not rendered in man pages */ -}}"
+	indexTmpl              = "{{- /* index is not rendered in man pages */ -}}"
+	indexEntryTmpl         = "{{- /* index is not rendered in man pages */ -}}"
+)
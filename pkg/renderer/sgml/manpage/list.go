@@ -0,0 +1,55 @@
+package manpage
+
+const (
+	unorderedListTmpl = `{{ if .Title }}.sp
+.B {{ .Title }}
+.br
+{{ end }}.RS 4
+{{ .Content }}.RE
+`
+
+	unorderedListElementTmpl = `.IP \(bu 2
+{{ .Content }}`
+
+	orderedListTmpl = `{{ if .Title }}.sp
+.B {{ .Title }}
+.br
+{{ end }}.RS 4
+{{ .Content }}.RE
+`
+
+	orderedListElementTmpl = `.IP "{{ .Number }}." 4
+{{ .Content }}`
+
+	// Continuation items (multiple terms sharing a single definition) are rendered with the `.TQ` macro
+	labeledListTmpl = `{{ if .Title }}.sp
+.B {{ .Title }}
+.br
+{{ end }}{{ .Content }}`
+
+	labeledListElementTmpl = `{{ if .Continuation }}.TQ{{ else }}.TP{{ end }}
+\fB{{ .Term }}\fP
+{{ .Content }}`
+
+	// there is no horizontal layout in man pages
+	labeledListHorizontalTmpl        = labeledListTmpl
+	labeledListHorizontalElementTmpl = labeledListElementTmpl
+
+	qAndAListTmpl = labeledListTmpl
+
+	qAndAListElementTmpl = `.TP
+\fI{{ .Term }}\fP
+{{ .Content }}`
+
+	calloutListTmpl = `{{ if .Title }}.sp
+.B {{ .Title }}
+.br
+{{ end }}.RS 4
+{{ .Content }}.RE
+`
+
+	calloutListElementTmpl = `.IP \fB({{ .Ref }})\fP 4
+{{ .Content }}`
+
+	calloutRefTmpl = `\fB({{ .Ref }})\fP`
+)
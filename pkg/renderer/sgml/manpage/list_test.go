@@ -0,0 +1,60 @@
+package manpage_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lists", func() {
+
+	It("nested unordered lists", func() {
+		source := `* item 1
+** item 1.1
+* item 2`
+		expected := `.RS 4
+.IP \(bu 2
+item 1
+.RS 4
+.IP \(bu 2
+item 1.1
+.RE
+.IP \(bu 2
+item 2
+.RE
+`
+		Expect(RenderManpage(source)).To(MatchHTML(expected))
+	})
+
+	It("ordered list with start", func() {
+		source := `[start=3]
+. item 1
+. item 2`
+		expected := `.RS 4
+.IP "3." 4
+item 1
+.IP "4." 4
+item 2
+.RE
+`
+		Expect(RenderManpage(source)).To(MatchHTML(expected))
+	})
+
+	It("labeled list with multiple terms", func() {
+		source := `--foo::
+-f::
+the foo option
+--bar:: the bar option`
+		expected := `.TP
+\fB\-\-foo\fP
+.TQ
+\fB\-f\fP
+the foo option
+.TP
+\fB\-\-bar\fP
+the bar option
+`
+		Expect(RenderManpage(source)).To(MatchHTML(expected))
+	})
+})
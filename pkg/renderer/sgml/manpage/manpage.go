@@ -0,0 +1,216 @@
+package manpage
+
+import (
+	"bytes"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// Render renders the document to the output, using the SGML renderer configured with the roff templates,
+// then converts the result into a troff/groff document which uses the `man` macros.
+func Render(doc *types.Document, config *configuration.Configuration, output io.Writer) (types.Metadata, error) {
+	c := *config
+	c.SoftAttributes = config.SoftAttributes.Clone()
+	if err := setManpageAttributes(doc, &c); err != nil {
+		return types.Metadata{}, err
+	}
+	tmpls := templates
+	if c.TemplateDir != "" {
+		// the templates of the directory are marked as the built-in templates,
+		// so they are loaded here rather than by the SGML renderer
+		var err error
+		if tmpls, err = sgml.LoadTemplates(tmpls, c.TemplateDir, roff); err != nil {
+			return types.Metadata{}, err
+		}
+		c.TemplateDir = ""
+	}
+	result := &bytes.Buffer{}
+	metadata, err := sgml.Render(doc, &c, result, tmpls)
+	if err != nil {
+		return metadata, err
+	}
+	if _, err = io.WriteString(output, manify(result.String())); err != nil {
+		return metadata, errors.Wrap(err, "unable to render manpage")
+	}
+	return metadata, nil
+}
+
+// the `mantitle(manvolnum)` pattern of the document title
+var manTitleRegexp = regexp.MustCompile(`^(.+)\((\w+)\)$`)
+
+// setManpageAttributes sets the `mantitle`, `manvolnum`, `manmanual` and `mansource` attributes
+// used in the `.TH` macro, unless they were already defined. These attributes are soft-set, so they can be declared in the document header.
+func setManpageAttributes(doc *types.Document, config *configuration.Configuration) error {
+	title := ""
+	if header, _ := doc.Header(); header != nil && header.Title != nil {
+		t, err := sgml.RenderPlainText(header.Title, sgml.WithoutEscape())
+		if err != nil {
+			return errors.Wrap(err, "unable to render manpage title")
+		}
+		title = strings.TrimSpace(t)
+	}
+	volnum := "1"
+	if m := manTitleRegexp.FindStringSubmatch(title); m != nil {
+		title = m[1]
+		volnum = m[2]
+	}
+	defaults := map[string]string{
+		types.AttrManTitle:  title,
+		types.AttrManVolNum: volnum,
+		types.AttrManManual: "",
+		types.AttrManSource: "",
+	}
+	for k, v := range defaults {
+		if !config.Attributes.Has(k) && !config.SoftAttributes.Has(k) && !config.UnsetAttributes[k] {
+			config.SoftAttributes[k] = v
+		}
+	}
+	return nil
+}
+
+// esc the character which precedes the troff control characters and escape sequences
+// in the templates, so they can be distinguished from the same characters in the document content
+const esc = '\x1b'
+
+// roff marks the backslashes, double quotes and control characters at the beginning of a line
+// (or right after a template action, such as in `{{ end }}.RE`) in the given template,
+// so they are not escaped in the final document.
+// Template actions (ie, `{{ ... }}`) are left unchanged.
+func roff(tmpl string) string {
+	result := &strings.Builder{}
+	result.Grow(len(tmpl))
+	inAction := false
+	for i := 0; i < len(tmpl); i++ {
+		c := tmpl[i]
+		switch {
+		case !inAction && strings.HasPrefix(tmpl[i:], "{{"):
+			inAction = true
+		case inAction && strings.HasPrefix(tmpl[i:], "}}"):
+			inAction = false
+			result.WriteString("}}")
+			i++
+			continue
+		case !inAction && (c == '\\' || c == '"'):
+			result.WriteByte(esc)
+		case !inAction && (c == '.' || c == '\'') && (i == 0 || tmpl[i-1] == '\n' || strings.HasSuffix(tmpl[:i], "}}")):
+			result.WriteByte(esc)
+		}
+		result.WriteByte(c)
+	}
+	return result.String()
+}
+
+// glyphs the troff special characters to use in place of the "typographic" characters
+// rendered by the SGML renderer
+var glyphs = map[rune]string{
+	'\\':     `\(rs`,
+	'-':      `\-`,
+	'\'':     `\(aq`,
+	'\u00a0': `\~`,
+	'©':      `\(co`,
+	'®':      `\(rg`,
+	'™':      `\(tm`,
+	'\u2009': ` `,
+	'\u200b': `\:`,
+	'–':      `\(en`,
+	'—':      `\(em`,
+	'‘':      `\(oq`,
+	'’':      `\(cq`,
+	'“':      `\(lq`,
+	'”':      `\(rq`,
+	'…':      `...`,
+	'←':      `\(<-`,
+	'→':      `\(->`,
+	'⇐':      `\(lA`,
+	'⇒':      `\(rA`,
+}
+
+// manify converts the output of the templates into a valid troff document:
+// - the HTML entities are converted into their troff counterparts,
+// - the backslashes, dashes and quotes of the content are escaped,
+// - the lines of content starting with a period are escaped,
+// - leading spaces and blank lines are removed outside of no-fill blocks,
+// - redundant vertical spacing is removed (eg: at the beginning of a list item or a table cell)
+func manify(source string) string {
+	source = html.UnescapeString(source)
+	result := &strings.Builder{}
+	result.Grow(len(source))
+	nofill := false
+	skipSpacing := false
+	tag := false
+	for _, line := range strings.Split(source, "\n") {
+		if !nofill {
+			line = strings.TrimLeft(line, " ")
+			if line == "" {
+				continue
+			}
+		}
+		switch line {
+		case string(esc) + ".nf":
+			nofill = true
+		case string(esc) + ".fi":
+			nofill = false
+		case string(esc) + ".sp":
+			if skipSpacing {
+				continue
+			}
+		}
+		result.WriteString(escapeLine(line))
+		result.WriteByte('\n')
+		switch {
+		case hasRequest(line, ".IP"), hasRequest(line, ".RS"), hasRequest(line, ".br"), strings.HasSuffix(line, "T{"):
+			skipSpacing = true
+		case hasRequest(line, ".TP"), hasRequest(line, ".TQ"):
+			// the next line is the tag
+			tag = true
+			skipSpacing = false
+		default:
+			skipSpacing = tag
+			tag = false
+		}
+	}
+	// remove the trailing cell separator at the end of each table row
+	return strings.ReplaceAll(result.String(), "T}\t\n", "T}\n")
+}
+
+func hasRequest(line, request string) bool {
+	return strings.HasPrefix(line, string(esc)+request) && (len(line) == len(request)+1 || line[len(request)+1] == ' ')
+}
+
+func escapeLine(line string) string {
+	request := len(line) > 1 && line[0] == esc && (line[1] == '.' || line[1] == '\'')
+	if hasRequest(line, ".SH") {
+		// section titles are displayed in uppercase (but not the font escape sequences)
+		line = strings.ReplaceAll(strings.ToUpper(line), string(esc)+`\F`, string(esc)+`\f`)
+	}
+	result := &strings.Builder{}
+	result.Grow(len(line))
+	escaped := false
+	for i, c := range line {
+		switch {
+		case escaped:
+			result.WriteRune(c)
+			escaped = false
+		case c == esc:
+			escaped = true
+		case i == 0 && c == '.':
+			result.WriteString(`\&.`)
+		case c == '"' && request:
+			result.WriteString(`\(dq`)
+		default:
+			if g, found := glyphs[c]; found {
+				result.WriteString(g)
+				continue
+			}
+			result.WriteRune(c)
+		}
+	}
+	return result.String()
+}
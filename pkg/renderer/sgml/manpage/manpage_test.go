@@ -0,0 +1,108 @@
+package manpage_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("manpages", func() {
+
+	It("full document", func() {
+		source := `= git-foo(8)
+John Doe
+v1.0, 2021-01-01
+:manmanual: Git Manual
+:mansource: Git 2.0
+
+== Name
+
+git-foo - does foo things
+
+== Synopsis
+
+*git foo* [_--bar_] <file>...
+
+== Description
+
+a description.`
+		expected := `'\" t
+.\"     Title: git\-foo
+.\"    Author: John Doe
+.\" Generator: libasciidoc
+.\"      Date: 2021\-01\-01
+.\"    Manual: Git Manual
+.\"    Source: Git 2.0
+.\"  Language: English
+.\"
+.TH "GIT\-FOO" "8" "2021\-01\-01" "Git 2.0" "Git Manual"
+.nh
+.ad l
+.SH "NAME"
+git\-foo \- does foo things
+.SH "SYNOPSIS"
+.sp
+\fBgit foo\fP [\fI\-\-bar\fP] <file>...\:
+.SH "DESCRIPTION"
+.sp
+a description.
+.SH "AUTHOR(S)"
+.sp
+\fBJohn Doe\fP
+`
+		Expect(RenderManpage(source, configuration.WithHeaderFooter(true))).To(MatchHTML(expected))
+	})
+
+	It("document body only", func() {
+		source := `= git-foo(1)
+
+== Name
+
+git-foo - does foo things
+
+== Synopsis
+
+*git foo*`
+		expected := `.SH "NAME"
+git\-foo \- does foo things
+.SH "SYNOPSIS"
+.sp
+\fBgit foo\fP
+`
+		Expect(RenderManpage(source)).To(MatchHTML(expected))
+	})
+
+	It("article without manpage structure", func() {
+		source := `= foo
+
+== Some section
+
+content`
+		expected := `.SH "SOME SECTION"
+.sp
+content
+`
+		Expect(RenderManpage(source)).To(MatchHTML(expected))
+	})
+
+	It("with template dir", func() {
+		templateDir, err := os.MkdirTemp("", "libasciidoc-templates")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(os.RemoveAll, templateDir)
+		// same as the built-in template, in which the control characters and escape sequences must not be escaped
+		Expect(os.WriteFile(filepath.Join(templateDir, "BoldText.tmpl"), []byte(`\fB{{ .Content }}\fR
+`), 0644)).To(Succeed())
+		source := `= foo
+
+a *bold* word`
+		expected := `.sp
+a \fBbold\fR word
+`
+		Expect(RenderManpage(source, configuration.WithTemplateDir(templateDir))).To(MatchHTML(expected))
+	})
+})
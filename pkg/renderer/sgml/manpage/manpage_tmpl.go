@@ -0,0 +1,8 @@
+package manpage
+
+const (
+	manpageHeaderTmpl = `.SH "{{ .Name }}"
+{{ .Content }}
+`
+	manpageNameParagraphTmpl = "{{ .Content }}"
+)
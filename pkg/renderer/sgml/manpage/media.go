@@ -0,0 +1,16 @@
+package manpage
+
+const (
+	// videos and audios can't be played in man pages, so only their location is rendered
+	videoBlockTmpl = `.sp
+{{ if .Title }}.B {{ .Title }}
+.br
+{{ end }}[video: {{ .Src }}]
+`
+
+	audioBlockTmpl = `.sp
+{{ if .Title }}.B {{ .Title }}
+.br
+{{ end }}[audio: {{ .Src }}]
+`
+)
@@ -0,0 +1,50 @@
+package manpage
+
+const (
+	paragraphTmpl = `.sp
+{{ if .Title }}.B {{ .Title }}
+.br
+{{ end }}{{ .Content }}
+`
+
+	admonitionParagraphTmpl = `{{ if .Content }}.sp
+.RS 4
+.B {{ .Icon }}{{ if .Title }}: {{ .Title }}{{ end }}
+.br
+{{ .Content }}
+.RE
+{{ end }}`
+
+	embeddedParagraphTmpl = `.sp
+{{ .CheckStyle }}{{ .Content }}
+`
+
+	verseParagraphTmpl = `.sp
+{{ if .Title }}.B {{ .Title }}
+.br
+{{ end }}.RS 4
+.nf
+{{ .Content }}
+.fi
+.RE
+{{ if .Attribution.First }}.RS 4
+\(em {{ .Attribution.First }}{{ if .Attribution.Second }}, \fI{{ .Attribution.Second }}\fP{{ end }}
+.RE
+{{ end }}`
+
+	quoteParagraphTmpl = `.sp
+{{ if .Title }}.B {{ .Title }}
+.br
+{{ end }}.RS 4
+{{ .Content }}
+.RE
+{{ if .Attribution.First }}.RS 4
+\(em {{ .Attribution.First }}{{ if .Attribution.Second }}, \fI{{ .Attribution.Second }}\fP{{ end }}
+.RE
+{{ end }}`
+
+	thematicBreakTmpl = `.sp
+.ce
+\l'\n(.lu*25u/100u\(ap'
+`
+)
@@ -0,0 +1,5 @@
+package manpage
+
+const (
+	preambleTmpl = "{{ .Content }}"
+)
@@ -0,0 +1,8 @@
+package manpage
+
+const (
+	sectionContentTmpl = "{{ .Header }}{{ .Content }}"
+
+	sectionTitleTmpl = `{{ if le .Level 1 }}.SH{{ else }}.SS{{ end }} "{{ .Content }}"
+`
+)
@@ -0,0 +1,16 @@
+package manpage
+
+const (
+	// equations are rendered in their source form, since troff cannot display MathML
+	stemBlockTmpl = `.sp
+{{ if .Title }}.B {{ .Title }}
+.br
+{{ end }}.RS 4
+.nf
+{{ .Source }}
+.fi
+.RE
+`
+
+	inlineStemTmpl = `{{ .Source }}`
+)
@@ -0,0 +1,34 @@
+package manpage
+
+const (
+	// tables are rendered with the `tbl` preprocessor, using tabs to separate the cells
+	tableTmpl = "{{ if .Body }}.sp\n" +
+		"{{ if .Title }}.B {{ .Caption }}{{ .Title }}\n.br\n{{ end }}" +
+		".TS\n" +
+		"{{ if eq .Frame \"all\" }}{{ if eq .Grid \"all\" }}allbox {{ else }}box {{ end }}{{ end }}tab(\t);\n" +
+		"{{ if .Header }}{{ range $i, $c := .Columns }}{{ if $i }} {{ end }}{{ slice (halignValue $c.HAlign) 0 1 }}tB{{ end }}\n{{ end }}" +
+		"{{ range $i, $c := .Columns }}{{ if $i }} {{ end }}{{ slice (halignValue $c.HAlign) 0 1 }}t{{ end }}.\n" +
+		"{{ .Header }}" +
+		"{{ .Body }}" +
+		"{{ .Footer }}" +
+		".TE\n" +
+		"{{ end }}"
+
+	tableBodyTmpl = "{{ .Content }}"
+
+	tableHeaderTmpl = "{{ if .Content }}{{ .Content }}\n{{ end }}"
+
+	tableHeaderCellTmpl = "T{\n{{ .Content }}\nT}\t"
+
+	tableFooterTmpl = "{{ if .Content }}{{ .Content }}\n{{ end }}"
+
+	tableFooterCellTmpl = "T{\n{{ .Content }}\nT}\t"
+
+	tableRowTmpl = "{{ .Content }}\n"
+
+	tableCellTmpl = "T{\n{{ .Content }}\nT}\t"
+
+	tableCellBlockTmpl = "{{ .Content }}"
+
+	tableCellLiteralTmpl = ".nf\n.fam C\n{{ .Content }}\n.fam\n.fi"
+)
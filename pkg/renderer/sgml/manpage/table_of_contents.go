@@ -0,0 +1,8 @@
+package manpage
+
+const (
+	// there is no table of contents in man pages
+	tocRootTmpl    = "{{- /* no table of contents in man pages */ -}}"
+	tocSectionTmpl = "{{- /* no table of contents in man pages */ -}}"
+	tocEntryTmpl   = "{{- /* no table of contents in man pages */ -}}"
+)
@@ -0,0 +1,102 @@
+package manpage
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml"
+)
+
+// Templates returns the default Templates use for man pages (roff).
+func Templates() sgml.Templates {
+	return templates
+}
+
+// the Templates used for man pages (roff).
+var templates = sgml.Templates{
+	AdmonitionBlock:              roff(admonitionBlockTmpl),
+	AdmonitionParagraph:          roff(admonitionParagraphTmpl),
+	Article:                      roff(articleTmpl),
+	ArticleHeader:                roff(articleHeaderTmpl),
+	AudioBlock:                   roff(audioBlockTmpl),
+	BibliographyAnchor:           roff(bibliographyAnchorTmpl),
+	BibliographyBlock:            roff(bibliographyBlockTmpl),
+	BlockImage:                   roff(blockImageTmpl),
+	BoldText:                     roff(boldTextTmpl),
+	CalloutList:                  roff(calloutListTmpl),
+	CalloutListElement:           roff(calloutListElementTmpl),
+	CalloutRef:                   roff(calloutRefTmpl),
+	ConcealedIndexTerm:           roff(concealedIndexTermTmpl),
+	DocumentDetails:              roff(documentDetailsTmpl),
+	DocumentAuthorDetails:        roff(documentAuthorDetailsTmpl),
+	EmbeddedParagraph:            roff(embeddedParagraphTmpl),
+	ExternalCrossReference:       roff(externalCrossReferenceTmpl),
+	ExampleBlock:                 roff(exampleBlockTmpl),
+	FencedBlock:                  roff(fencedBlockTmpl),
+	Footnote:                     roff(footnoteTmpl),
+	FootnoteElement:              roff(footnoteElementTmpl),
+	FootnoteRef:                  roff(footnoteRefTmpl),
+	Footnotes:                    roff(footnotesTmpl),
+	IconFont:                     roff(iconFontTmpl),
+	IconImage:                    roff(iconImageTmpl),
+	IconSVG:                      roff(iconSVGTmpl),
+	IconText:                     roff(iconTextTmpl),
+	Index:                        roff(indexTmpl),
+	IndexEntry:                   roff(indexEntryTmpl),
+	IndexTerm:                    roff(indexTermTmpl),
+	InlineButton:                 roff(inlineButtonTmpl),
+	InlineCitation:               roff(inlineCitationTmpl),
+	InlineIcon:                   roff(inlineIconTmpl),
+	InlineImage:                  roff(inlineImageTmpl),
+	InlineMenu:                   roff(inlineMenuTmpl),
+	InlineStem:                   roff(inlineStemTmpl),
+	InternalCrossReference:       roff(internalCrossReferenceTmpl),
+	InvalidFootnote:              roff(invalidFootnoteTmpl),
+	ItalicText:                   roff(italicTextTmpl),
+	LabeledList:                  roff(labeledListTmpl),
+	LabeledListElement:           roff(labeledListElementTmpl),
+	LabeledListHorizontal:        roff(labeledListHorizontalTmpl),
+	LabeledListHorizontalElement: roff(labeledListHorizontalElementTmpl),
+	LineBreak:                    roff(lineBreakTmpl),
+	Link:                         roff(linkTmpl),
+	ListingBlock:                 roff(listingBlockTmpl),
+	LiteralBlock:                 roff(literalBlockTmpl),
+	ManpageHeader:                roff(manpageHeaderTmpl),
+	ManpageNameParagraph:         roff(manpageNameParagraphTmpl),
+	MarkdownQuoteBlock:           roff(markdownQuoteBlockTmpl),
+	MarkedText:                   roff(markedTextTmpl),
+	MonospaceText:                roff(monospaceTextTmpl),
+	OpenBlock:                    roff(openBlockTmpl),
+	OrderedList:                  roff(orderedListTmpl),
+	OrderedListElement:           roff(orderedListElementTmpl),
+	PassthroughBlock:             roff(passthroughBlock),
+	Paragraph:                    roff(paragraphTmpl),
+	Preamble:                     roff(preambleTmpl),
+	QAndAList:                    roff(qAndAListTmpl),
+	QAndAListElement:             roff(qAndAListElementTmpl),
+	QuoteBlock:                   roff(quoteBlockTmpl),
+	QuoteParagraph:               roff(quoteParagraphTmpl),
+	SectionContent:               roff(sectionContentTmpl),
+	SectionTitle:                 roff(sectionTitleTmpl),
+	SidebarBlock:                 roff(sidebarBlockTmpl),
+	SourceBlock:                  roff(sourceBlockTmpl),
+	StemBlock:                    roff(stemBlockTmpl),
+	SubscriptText:                roff(subscriptTextTmpl),
+	SuperscriptText:              roff(superscriptTextTmpl),
+	Table:                        roff(tableTmpl),
+	TableBody:                    roff(tableBodyTmpl),
+	TableCell:                    roff(tableCellTmpl),
+	TableCellBlock:               roff(tableCellBlockTmpl),
+	TableCellLiteral:             roff(tableCellLiteralTmpl),
+	TableHeader:                  roff(tableHeaderTmpl),
+	TableHeaderCell:              roff(tableHeaderCellTmpl),
+	TableFooter:                  roff(tableFooterTmpl),
+	TableFooterCell:              roff(tableFooterCellTmpl),
+	TableRow:                     roff(tableRowTmpl),
+	ThematicBreak:                roff(thematicBreakTmpl),
+	TocRoot:                      roff(tocRootTmpl),
+	TocEntry:                     roff(tocEntryTmpl),
+	TocSection:                   roff(tocSectionTmpl),
+	UnorderedList:                roff(unorderedListTmpl),
+	UnorderedListElement:         roff(unorderedListElementTmpl),
+	VerseBlock:                   roff(verseBlockTmpl),
+	VerseParagraph:               roff(verseParagraphTmpl),
+	VideoBlock:                   roff(videoBlockTmpl),
+}
@@ -0,0 +1,27 @@
+package manpage_test
+
+import (
+	"reflect"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml/manpage"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("fields", func() {
+
+	It("template fields are not empty", func() {
+		tmp := manpage.Templates() // sgml.Templates
+		typ := reflect.TypeOf(tmp)
+		val := reflect.ValueOf(tmp)
+
+		for i := 0; i < typ.NumField(); i++ {
+			fn := typ.Field(i).Name
+			fv := val.FieldByName(fn)
+
+			s, ok := fv.Interface().(string)
+			Expect(ok).To(BeTrue())
+			Expect(s).NotTo(BeEmpty())
+		}
+	})
+})
@@ -0,0 +1,154 @@
+package sgml
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+func (r *sgmlRenderer) renderVideoBlock(ctx *context, v *types.VideoBlock) (string, error) {
+	title, err := r.renderElementTitle(ctx, v.Attributes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render video")
+	}
+	roles, err := r.renderElementRoles(ctx, v.Attributes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render video")
+	}
+	var src, poster string
+	switch v.Service() {
+	case types.YouTube:
+		src = youTubeSrc(v)
+	case types.Vimeo:
+		src = vimeoSrc(v)
+	default:
+		src = r.getMediaSrc(ctx, v.Location, v.Attributes)
+		if p := v.Attributes.GetAsStringWithDefault(types.AttrMediaPoster, ""); p != "" {
+			poster = r.getMediaSrc(ctx, &types.Location{Path: p}, nil)
+		}
+	}
+	return r.execute(r.videoBlock, struct {
+		ID         string
+		Title      string
+		Roles      string
+		Service    string
+		Src        string
+		Poster     string
+		Width      string
+		Height     string
+		Autoplay   bool
+		Controls   bool
+		Loop       bool
+		Muted      bool
+		FullScreen bool
+	}{
+		ID:         r.renderElementID(v.Attributes),
+		Title:      title,
+		Roles:      roles,
+		Service:    v.Service(),
+		Src:        src,
+		Poster:     poster,
+		Width:      v.Attributes.GetAsStringWithDefault(types.AttrWidth, ""),
+		Height:     v.Attributes.GetAsStringWithDefault(types.AttrHeight, ""),
+		Autoplay:   v.Attributes.HasOption(types.AttrMediaAutoplay),
+		Controls:   !v.Attributes.HasOption(types.AttrMediaNoControls),
+		Loop:       v.Attributes.HasOption(types.AttrMediaLoop),
+		Muted:      v.Attributes.HasOption(types.AttrMediaMuted),
+		FullScreen: !v.Attributes.HasOption(types.AttrMediaNoFullScreen),
+	})
+}
+
+func (r *sgmlRenderer) renderAudioBlock(ctx *context, a *types.AudioBlock) (string, error) {
+	title, err := r.renderElementTitle(ctx, a.Attributes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render audio")
+	}
+	roles, err := r.renderElementRoles(ctx, a.Attributes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render audio")
+	}
+	return r.execute(r.audioBlock, struct {
+		ID       string
+		Title    string
+		Roles    string
+		Src      string
+		Autoplay bool
+		Controls bool
+		Loop     bool
+	}{
+		ID:       r.renderElementID(a.Attributes),
+		Title:    title,
+		Roles:    roles,
+		Src:      r.getMediaSrc(ctx, a.Location, a.Attributes),
+		Autoplay: a.Attributes.HasOption(types.AttrMediaAutoplay),
+		Controls: !a.Attributes.HasOption(types.AttrMediaNoControls),
+		Loop:     a.Attributes.HasOption(types.AttrMediaLoop),
+	})
+}
+
+// getMediaSrc returns the location of the media file, relative to the `imagesdir` (as done by Asciidoctor),
+// with the optional `#t=start,end` media fragment
+func (r *sgmlRenderer) getMediaSrc(ctx *context, location *types.Location, attrs types.Attributes) string {
+	if imagesdir, found := ctx.attributes.GetAsString(types.AttrImagesDir); found {
+		location.SetPathPrefix(imagesdir)
+	}
+	src := location.ToString()
+	start, hasStart := attrs.GetAsString(types.AttrMediaStart)
+	end, hasEnd := attrs.GetAsString(types.AttrMediaEnd)
+	if hasStart || hasEnd {
+		src += "#t=" + start
+		if hasEnd {
+			src += "," + end
+		}
+	}
+	return src
+}
+
+func youTubeSrc(v *types.VideoBlock) string {
+	id := v.Location.ToString()
+	src := &strings.Builder{}
+	src.WriteString("https://www.youtube.com/embed/" + id + "?rel=0")
+	if start, found := v.Attributes.GetAsString(types.AttrMediaStart); found {
+		src.WriteString("&amp;start=" + start)
+	}
+	if end, found := v.Attributes.GetAsString(types.AttrMediaEnd); found {
+		src.WriteString("&amp;end=" + end)
+	}
+	if v.Attributes.HasOption(types.AttrMediaAutoplay) {
+		src.WriteString("&amp;autoplay=1")
+	}
+	if v.Attributes.HasOption(types.AttrMediaLoop) {
+		// a single video is looped when it is its own playlist
+		src.WriteString("&amp;loop=1&amp;playlist=" + id)
+	}
+	if v.Attributes.HasOption(types.AttrMediaMuted) {
+		src.WriteString("&amp;mute=1")
+	}
+	if v.Attributes.HasOption(types.AttrMediaNoControls) {
+		src.WriteString("&amp;controls=0")
+	}
+	return src.String()
+}
+
+func vimeoSrc(v *types.VideoBlock) string {
+	params := []string{}
+	if v.Attributes.HasOption(types.AttrMediaAutoplay) {
+		params = append(params, "autoplay=1")
+	}
+	if v.Attributes.HasOption(types.AttrMediaLoop) {
+		params = append(params, "loop=1")
+	}
+	if v.Attributes.HasOption(types.AttrMediaMuted) {
+		params = append(params, "muted=1")
+	}
+	src := "https://player.vimeo.com/video/" + v.Location.ToString()
+	if len(params) > 0 {
+		src += "?" + strings.Join(params, "&amp;")
+	}
+	if start, found := v.Attributes.GetAsString(types.AttrMediaStart); found {
+		src += "#at=" + start
+	}
+	return src
+}
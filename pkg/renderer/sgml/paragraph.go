@@ -26,6 +26,8 @@ func (r *sgmlRenderer) renderParagraph(ctx *context, p *types.Paragraph) (string
 		return r.renderQuoteParagraph(ctx, p)
 	case types.Passthrough:
 		return r.renderPassthroughParagraph(ctx, p)
+	case types.Stem, types.LatexMath, types.AsciiMath:
+		return r.renderStemParagraph(ctx, p)
 	case "manpage":
 		return r.renderManpageNameParagraph(ctx, p)
 	case types.Tip, types.Note, types.Important, types.Warning, types.Caution:
@@ -76,6 +76,8 @@ func (r *plaintextRenderer) render(element interface{}) (string, error) {
 		return r.renderFootnoteReference(e)
 	case *types.InlinePassthrough:
 		return r.renderInlinePassthrough(e)
+	case *types.BibliographyAnchor:
+		return "[" + r.escape(e.Label) + "]", nil
 	default:
 		return "", errors.Errorf("unable to render plain string for element of type '%T'", e)
 	}
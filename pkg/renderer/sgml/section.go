@@ -16,10 +16,22 @@ func (r *sgmlRenderer) renderSection(ctx *context, s *types.Section) (string, er
 		return "", errors.Wrap(err, "error while rendering section title")
 	}
 
+	// lists in a bibliography section are bibliography lists
+	withinBibliography := ctx.withinBibliography
+	ctx.withinBibliography = s.GetStyle() == types.BibliographySection
 	content, err := r.renderElements(ctx, s.Elements)
+	ctx.withinBibliography = withinBibliography
 	if err != nil {
 		return "", errors.Wrap(err, "error while rendering section content")
 	}
+	// the index is generated after the (optional) content of the section
+	if s.GetStyle() == types.IndexSection {
+		index, err := r.renderIndex(ctx)
+		if err != nil {
+			return "", errors.Wrap(err, "error while rendering index")
+		}
+		content += index
+	}
 	roles, err := r.renderElementRoles(ctx, s.Attributes)
 	if err != nil {
 		return "", errors.Wrap(err, "unable to render section roles")
@@ -31,11 +43,13 @@ func (r *sgmlRenderer) renderSection(ctx *context, s *types.Section) (string, er
 		Elements []interface{}
 		ID       string
 		Roles    string
+		Kind     string
 		Level    int
 	}{
 		Context:  ctx,
 		Header:   title,
 		Level:    s.Level,
+		Kind:     sectionKind(ctx, s),
 		Elements: s.Elements,
 		ID:       r.renderElementID(s.Attributes),
 		Roles:    roles,
@@ -59,13 +73,70 @@ func (r *sgmlRenderer) renderSectionTitle(ctx *context, s *types.Section) (strin
 		Level        int
 		LevelPlusOne int
 		ID           string
+		Kind         string
 		Number       string
+		Caption      string
 		Content      string
 	}{
 		Level:        s.Level,
 		LevelPlusOne: s.Level + 1, // Level 1 is <h2>.
 		ID:           r.renderElementID(s.Attributes),
+		Kind:         sectionKind(ctx, s),
 		Number:       number,
+		Caption:      ctx.sectionCaptions[s.GetID()],
 		Content:      renderedContentStr,
 	})
 }
+
+// sectionKind returns the kind of the given section, i.e., its special style (`appendix`, `preface`, etc.) if any,
+// `part` or `chapter` for sections of level 0 and 1 in a book, or `section` otherwise
+func sectionKind(ctx *context, s *types.Section) string {
+	if s.IsSpecial() {
+		return s.GetStyle()
+	}
+	if ctx.attributes.GetAsStringWithDefault(types.AttrDocType, "article") == "book" {
+		switch s.Level {
+		case 0:
+			return "part"
+		case 1:
+			return "chapter"
+		}
+	}
+	return "section"
+}
+
+// prerenderSectionCaptions computes the caption of all sections (eg: `1.2. `, `Appendix A: `, `Part I: `, etc.)
+// so they can be used in section titles and in the table of contents
+func (r *sgmlRenderer) prerenderSectionCaptions(ctx *context, elements []interface{}) {
+	for _, e := range elements {
+		if s, ok := e.(*types.Section); ok {
+			if caption := sectionCaption(ctx, sectionKind(ctx, s), ctx.sectionNumbering[s.GetID()]); caption != "" {
+				ctx.sectionCaptions[s.GetID()] = caption
+			}
+			r.prerenderSectionCaptions(ctx, s.Elements)
+		}
+	}
+}
+
+func sectionCaption(ctx *context, kind, number string) string {
+	if number == "" {
+		return ""
+	}
+	switch kind {
+	case "part":
+		if signifier, found := ctx.attributes.GetAsString(types.AttrPartSignifier); found && signifier != "" {
+			return signifier + " " + number + ": "
+		}
+		return number + ": "
+	case types.AppendixSection:
+		if caption, found := ctx.attributes.GetAsString(types.AttrAppendixCaption); found && caption != "" {
+			return caption + " " + number + ": "
+		}
+		return number + ". "
+	case "chapter":
+		if signifier, found := ctx.attributes.GetAsString(types.AttrChapterSignifier); found && signifier != "" {
+			return signifier + " " + number + ". "
+		}
+	}
+	return number + ". "
+}
@@ -16,6 +16,12 @@ import (
 )
 
 func Render(doc *types.Document, config *configuration.Configuration, output io.Writer, tmpls Templates) (types.Metadata, error) {
+	if config.TemplateDir != "" {
+		var err error
+		if tmpls, err = LoadTemplates(tmpls, config.TemplateDir, nil); err != nil {
+			return types.Metadata{}, err
+		}
+	}
 	r := &sgmlRenderer{
 		templates: tmpls,
 		// Establish some default function handlers.
@@ -23,11 +29,14 @@ func Render(doc *types.Document, config *configuration.Configuration, output io.
 			"basename":           filepath.Base,
 			"escape":             escapeString,
 			"halign":             halign,
+			"halignValue":        halignValue,
 			"lastInStrings":      lastInStrings,
 			"toLower":            strings.ToLower,
+			"toUpper":            strings.ToUpper,
 			"trimLineFeedSuffix": trimLineFeedSuffix,
 			"unescape":           unescapeString,
 			"valign":             valign,
+			"valignValue":        valignValue,
 		},
 	}
 	ctx := newContext(doc, config)
@@ -53,9 +62,9 @@ func Render(doc *types.Document, config *configuration.Configuration, output io.
 		for _, e := range header.Elements {
 			switch e := e.(type) {
 			case *types.AttributeDeclaration:
-				ctx.attributes[e.Name] = e.Value
+				ctx.setAttribute(e.Name, e.Value)
 			case *types.AttributeReset:
-				delete(ctx.attributes, e.Name)
+				ctx.unsetAttribute(e.Name)
 			}
 		}
 	}
@@ -64,16 +73,21 @@ elements:
 	for _, e := range doc.Elements {
 		switch e := e.(type) {
 		case *types.AttributeDeclaration:
-			ctx.attributes[e.Name] = e.Value
+			ctx.setAttribute(e.Name, e.Value)
 		case *types.AttributeReset:
-			delete(ctx.attributes, e.Name)
+			ctx.unsetAttribute(e.Name)
 		default:
 			break elements
 		}
 	}
-	if ctx.sectionNumbering, err = doc.SectionNumbers(); err != nil {
+	metadata.ManTitle = ctx.attributes.GetAsStringWithDefault(types.AttrManTitle, "")
+	metadata.ManVolNum = ctx.attributes.GetAsStringWithDefault(types.AttrManVolNum, "")
+	if ctx.sectionNumbering, err = doc.SectionNumbers(ctx.attributes); err != nil {
 		return metadata, errors.Wrapf(err, "unable to render full document")
 	}
+	ctx.sectionCaptions = map[string]string{}
+	r.prerenderSectionCaptions(ctx, doc.Elements)
+	r.prerenderBibliography(ctx, doc.Elements)
 
 	// needs to be set before rendering the content elements
 	if err := r.prerenderTableOfContents(ctx, doc.TableOfContents); err != nil {
@@ -94,6 +108,15 @@ elements:
 		if err != nil {
 			return metadata, errors.Wrapf(err, "unable to render full document")
 		}
+		css, embeddedCSS := stylesheets(ctx)
+		docinfo, err := renderDocinfo(doc, ctx.config, configuration.DocinfoHead)
+		if err != nil {
+			return metadata, errors.Wrapf(err, "unable to render full document")
+		}
+		docinfoFooter, err := renderDocinfo(doc, ctx.config, configuration.DocinfoFooter)
+		if err != nil {
+			return metadata, errors.Wrapf(err, "unable to render full document")
+		}
 		err = tmpl.Execute(output, struct {
 			Doctype               string
 			Generator             string
@@ -107,6 +130,10 @@ elements:
 			RevNumber             string
 			LastUpdated           string
 			CSS                   []string
+			EmbeddedCSS           []string
+			Docinfo               string
+			DocinfoFooter         string
+			Attributes            types.Attributes
 			IncludeHTMLBodyHeader bool
 			IncludeHTMLBodyFooter bool
 		}{
@@ -121,7 +148,11 @@ elements:
 			Content:               string(renderedContent),
 			RevNumber:             ctx.attributes.GetAsStringWithDefault("revnumber", ""),
 			LastUpdated:           ctx.config.LastUpdated.Format(configuration.LastUpdatedFormat),
-			CSS:                   ctx.config.CSS,
+			CSS:                   css,
+			EmbeddedCSS:           embeddedCSS,
+			Docinfo:               docinfo,
+			DocinfoFooter:         docinfoFooter,
+			Attributes:            ctx.attributes,
 			IncludeHTMLBodyHeader: !ctx.attributes.Has(types.AttrNoHeader),
 			IncludeHTMLBodyFooter: !ctx.attributes.Has(types.AttrNoFooter),
 		})
@@ -202,6 +233,30 @@ func valign(v types.VAlign) string {
 	}
 }
 
+// halignValue returns the plain name of the given horizontal alignment (eg: for DocBook tables)
+func halignValue(v types.HAlign) string {
+	switch v {
+	case types.HAlignCenter:
+		return "center"
+	case types.HAlignRight:
+		return "right"
+	default:
+		return "left"
+	}
+}
+
+// valignValue returns the plain name of the given vertical alignment (eg: for DocBook tables)
+func valignValue(v types.VAlign) string {
+	switch v {
+	case types.VAlignMiddle:
+		return "middle"
+	case types.VAlignBottom:
+		return "bottom"
+	default:
+		return "top"
+	}
+}
+
 func lastInStrings(slice []string, index int) bool {
 	return index == len(slice)-1
 }
@@ -440,3 +495,18 @@ func lookupPreamble(elements []interface{}) *types.Preamble {
 	}
 	return nil
 }
+
+// renderDocinfo returns the content of the docinfo processors registered for the given location, separated by a newline
+func renderDocinfo(doc *types.Document, config *configuration.Configuration, location configuration.DocinfoLocation) (string, error) {
+	result := []string{}
+	for _, process := range config.Extensions.DocinfoProcessors[location] {
+		content, err := process(doc)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to render docinfo")
+		}
+		if content = strings.TrimRight(content, "\n"); content != "" {
+			result = append(result, content)
+		}
+	}
+	return strings.Join(result, "\n"), nil
+}
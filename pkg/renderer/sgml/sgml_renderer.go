@@ -25,6 +25,15 @@ type sgmlRenderer struct {
 	articleHeaderOnce sync.Once
 	articleHeaderTmpl *texttemplate.Template
 
+	audioBlockOnce sync.Once
+	audioBlockTmpl *texttemplate.Template
+
+	bibliographyAnchorOnce sync.Once
+	bibliographyAnchorTmpl *texttemplate.Template
+
+	bibliographyBlockOnce sync.Once
+	bibliographyBlockTmpl *texttemplate.Template
+
 	blockImageOnce sync.Once
 	blockImageTmpl *texttemplate.Template
 
@@ -40,6 +49,9 @@ type sgmlRenderer struct {
 	calloutRefOnce sync.Once
 	calloutRefTmpl *texttemplate.Template
 
+	concealedIndexTermOnce sync.Once
+	concealedIndexTermTmpl *texttemplate.Template
+
 	embeddedParagraphOnce sync.Once
 	embeddedParagraphTmpl *texttemplate.Template
 
@@ -76,12 +88,27 @@ type sgmlRenderer struct {
 	iconImageOnce sync.Once
 	iconImageTmpl *texttemplate.Template
 
+	iconSVGOnce sync.Once
+	iconSVGTmpl *texttemplate.Template
+
 	iconTextOnce sync.Once
 	iconTextTmpl *texttemplate.Template
 
+	indexOnce sync.Once
+	indexTmpl *texttemplate.Template
+
+	indexEntryOnce sync.Once
+	indexEntryTmpl *texttemplate.Template
+
+	indexTermOnce sync.Once
+	indexTermTmpl *texttemplate.Template
+
 	inlineButtonOnce sync.Once
 	inlineButtonTmpl *texttemplate.Template
 
+	inlineCitationOnce sync.Once
+	inlineCitationTmpl *texttemplate.Template
+
 	inlineIconOnce sync.Once
 	inlineIconTmpl *texttemplate.Template
 
@@ -91,6 +118,9 @@ type sgmlRenderer struct {
 	inlineMenuOnce sync.Once
 	inlineMenuTmpl *texttemplate.Template
 
+	inlineStemOnce sync.Once
+	inlineStemTmpl *texttemplate.Template
+
 	internalCrossReferenceOnce sync.Once
 	internalCrossReferenceTmpl *texttemplate.Template
 
@@ -181,6 +211,9 @@ type sgmlRenderer struct {
 	sourceBlockOnce sync.Once
 	sourceBlockTmpl *texttemplate.Template
 
+	stemBlockOnce sync.Once
+	stemBlockTmpl *texttemplate.Template
+
 	subscriptTextOnce sync.Once
 	subscriptTextTmpl *texttemplate.Template
 
@@ -199,6 +232,9 @@ type sgmlRenderer struct {
 	tableCellBlockOnce sync.Once
 	tableCellBlockTmpl *texttemplate.Template
 
+	tableCellLiteralOnce sync.Once
+	tableCellLiteralTmpl *texttemplate.Template
+
 	tableHeaderOnce sync.Once
 	tableHeaderTmpl *texttemplate.Template
 
@@ -237,6 +273,9 @@ type sgmlRenderer struct {
 
 	verseParagraphOnce sync.Once
 	verseParagraphTmpl *texttemplate.Template
+
+	videoBlockOnce sync.Once
+	videoBlockTmpl *texttemplate.Template
 }
 
 type template func() (*texttemplate.Template, error)
@@ -302,6 +341,30 @@ func (r *sgmlRenderer) articleHeader() (*texttemplate.Template, error) {
 	return r.articleHeaderTmpl, err
 }
 
+func (r *sgmlRenderer) audioBlock() (*texttemplate.Template, error) {
+	var err error
+	r.audioBlockOnce.Do(func() {
+		r.audioBlockTmpl, err = r.newTemplate("AudioBlock", r.templates.AudioBlock, err)
+	})
+	return r.audioBlockTmpl, err
+}
+
+func (r *sgmlRenderer) bibliographyAnchor() (*texttemplate.Template, error) {
+	var err error
+	r.bibliographyAnchorOnce.Do(func() {
+		r.bibliographyAnchorTmpl, err = r.newTemplate("BibliographyAnchor", r.templates.BibliographyAnchor, err)
+	})
+	return r.bibliographyAnchorTmpl, err
+}
+
+func (r *sgmlRenderer) bibliographyBlock() (*texttemplate.Template, error) {
+	var err error
+	r.bibliographyBlockOnce.Do(func() {
+		r.bibliographyBlockTmpl, err = r.newTemplate("BibliographyBlock", r.templates.BibliographyBlock, err)
+	})
+	return r.bibliographyBlockTmpl, err
+}
+
 func (r *sgmlRenderer) blockImage() (*texttemplate.Template, error) {
 	var err error
 	r.blockImageOnce.Do(func() {
@@ -342,6 +405,14 @@ func (r *sgmlRenderer) calloutRef() (*texttemplate.Template, error) {
 	return r.calloutRefTmpl, err
 }
 
+func (r *sgmlRenderer) concealedIndexTerm() (*texttemplate.Template, error) {
+	var err error
+	r.concealedIndexTermOnce.Do(func() {
+		r.concealedIndexTermTmpl, err = r.newTemplate("ConcealedIndexTerm", r.templates.ConcealedIndexTerm, err)
+	})
+	return r.concealedIndexTermTmpl, err
+}
+
 func (r *sgmlRenderer) embeddedParagraph() (*texttemplate.Template, error) {
 	var err error
 	r.embeddedParagraphOnce.Do(func() {
@@ -438,6 +509,14 @@ func (r *sgmlRenderer) iconImage() (*texttemplate.Template, error) {
 	return r.iconImageTmpl, err
 }
 
+func (r *sgmlRenderer) iconSVG() (*texttemplate.Template, error) {
+	var err error
+	r.iconSVGOnce.Do(func() {
+		r.iconSVGTmpl, err = r.newTemplate("IconSVG", r.templates.IconSVG, err)
+	})
+	return r.iconSVGTmpl, err
+}
+
 func (r *sgmlRenderer) iconText() (*texttemplate.Template, error) {
 	var err error
 	r.iconTextOnce.Do(func() {
@@ -446,6 +525,30 @@ func (r *sgmlRenderer) iconText() (*texttemplate.Template, error) {
 	return r.iconTextTmpl, err
 }
 
+func (r *sgmlRenderer) index() (*texttemplate.Template, error) {
+	var err error
+	r.indexOnce.Do(func() {
+		r.indexTmpl, err = r.newTemplate("Index", r.templates.Index, err)
+	})
+	return r.indexTmpl, err
+}
+
+func (r *sgmlRenderer) indexEntry() (*texttemplate.Template, error) {
+	var err error
+	r.indexEntryOnce.Do(func() {
+		r.indexEntryTmpl, err = r.newTemplate("IndexEntry", r.templates.IndexEntry, err)
+	})
+	return r.indexEntryTmpl, err
+}
+
+func (r *sgmlRenderer) indexTerm() (*texttemplate.Template, error) {
+	var err error
+	r.indexTermOnce.Do(func() {
+		r.indexTermTmpl, err = r.newTemplate("IndexTerm", r.templates.IndexTerm, err)
+	})
+	return r.indexTermTmpl, err
+}
+
 func (r *sgmlRenderer) inlineButton() (*texttemplate.Template, error) {
 	var err error
 	r.inlineButtonOnce.Do(func() {
@@ -454,6 +557,14 @@ func (r *sgmlRenderer) inlineButton() (*texttemplate.Template, error) {
 	return r.inlineButtonTmpl, err
 }
 
+func (r *sgmlRenderer) inlineCitation() (*texttemplate.Template, error) {
+	var err error
+	r.inlineCitationOnce.Do(func() {
+		r.inlineCitationTmpl, err = r.newTemplate("InlineCitation", r.templates.InlineCitation, err)
+	})
+	return r.inlineCitationTmpl, err
+}
+
 func (r *sgmlRenderer) inlineIcon() (*texttemplate.Template, error) {
 	var err error
 	r.inlineIconOnce.Do(func() {
@@ -478,6 +589,14 @@ func (r *sgmlRenderer) inlineMenu() (*texttemplate.Template, error) {
 	return r.inlineMenuTmpl, err
 }
 
+func (r *sgmlRenderer) inlineStem() (*texttemplate.Template, error) {
+	var err error
+	r.inlineStemOnce.Do(func() {
+		r.inlineStemTmpl, err = r.newTemplate("InlineStem", r.templates.InlineStem, err)
+	})
+	return r.inlineStemTmpl, err
+}
+
 func (r *sgmlRenderer) internalCrossReference() (*texttemplate.Template, error) {
 	var err error
 	r.internalCrossReferenceOnce.Do(func() {
@@ -718,6 +837,14 @@ func (r *sgmlRenderer) sourceBlock() (*texttemplate.Template, error) {
 	return r.sourceBlockTmpl, err
 }
 
+func (r *sgmlRenderer) stemBlock() (*texttemplate.Template, error) {
+	var err error
+	r.stemBlockOnce.Do(func() {
+		r.stemBlockTmpl, err = r.newTemplate("StemBlock", r.templates.StemBlock, err)
+	})
+	return r.stemBlockTmpl, err
+}
+
 func (r *sgmlRenderer) subscriptText() (*texttemplate.Template, error) {
 	var err error
 	r.subscriptTextOnce.Do(func() {
@@ -766,6 +893,14 @@ func (r *sgmlRenderer) tableCellBlock() (*texttemplate.Template, error) {
 	return r.tableCellBlockTmpl, err
 }
 
+func (r *sgmlRenderer) tableCellLiteral() (*texttemplate.Template, error) {
+	var err error
+	r.tableCellLiteralOnce.Do(func() {
+		r.tableCellLiteralTmpl, err = r.newTemplate("TableCellLiteral", r.templates.TableCellLiteral, err)
+	})
+	return r.tableCellLiteralTmpl, err
+}
+
 func (r *sgmlRenderer) tableHeader() (*texttemplate.Template, error) {
 	var err error
 	r.tableHeaderOnce.Do(func() {
@@ -869,3 +1004,11 @@ func (r *sgmlRenderer) verseParagraph() (*texttemplate.Template, error) {
 	})
 	return r.verseParagraphTmpl, err
 }
+
+func (r *sgmlRenderer) videoBlock() (*texttemplate.Template, error) {
+	var err error
+	r.videoBlockOnce.Do(func() {
+		r.videoBlockTmpl, err = r.newTemplate("VideoBlock", r.templates.VideoBlock, err)
+	})
+	return r.videoBlockTmpl, err
+}
@@ -0,0 +1,88 @@
+package sgml
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/mathml"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func (r *sgmlRenderer) renderStemBlock(ctx *context, b *types.DelimitedBlock) (string, error) {
+	return r.renderStem(ctx, b.Attributes, b.Elements)
+}
+
+func (r *sgmlRenderer) renderStemParagraph(ctx *context, p *types.Paragraph) (string, error) {
+	return r.renderStem(ctx, p.Attributes, p.Elements)
+}
+
+func (r *sgmlRenderer) renderStem(ctx *context, attrs types.Attributes, elements []interface{}) (string, error) {
+	source, err := r.renderPassthroughContent(ctx, elements)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render stem block content")
+	}
+	roles, err := r.renderElementRoles(ctx, attrs)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render stem block roles")
+	}
+	title, err := r.renderElementTitle(ctx, attrs)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render stem block title")
+	}
+	kind := stemInterpreter(ctx, attrs.GetAsStringWithDefault(types.AttrStyle, types.Stem))
+	return r.execute(r.stemBlock, struct {
+		Context *context
+		ID      string
+		Roles   string
+		Title   string
+		Kind    string
+		Source  string
+		Content string
+	}{
+		Context: ctx,
+		ID:      r.renderElementID(attrs),
+		Roles:   roles,
+		Title:   title,
+		Kind:    kind,
+		Source:  escapeString(source),
+		Content: toMathML(kind, source, true),
+	})
+}
+
+func (r *sgmlRenderer) renderInlineStem(ctx *context, s *types.InlineStem) (string, error) {
+	kind := stemInterpreter(ctx, s.Kind)
+	return r.execute(r.inlineStem, struct {
+		Context *context
+		Kind    string
+		Source  string
+		Content string
+	}{
+		Context: ctx,
+		Kind:    kind,
+		Source:  escapeString(s.Content),
+		Content: toMathML(kind, s.Content, false),
+	})
+}
+
+// stemInterpreter returns the interpreter (`asciimath` or `latexmath`) to use for the given
+// kind of STEM block or macro. The `stem` kind uses the interpreter defined in the `stem` document
+// attribute (or `asciimath` by default)
+func stemInterpreter(ctx *context, kind string) string {
+	if kind != types.Stem {
+		return kind
+	}
+	switch ctx.attributes.GetAsStringWithDefault(types.AttrStem, "") {
+	case types.LatexMath, "latex", "tex":
+		return types.LatexMath
+	default:
+		return types.AsciiMath
+	}
+}
+
+func toMathML(kind, source string, block bool) string {
+	source = strings.TrimSpace(source)
+	if kind == types.LatexMath {
+		return mathml.FromLatexMath(source, block)
+	}
+	return mathml.FromAsciiMath(source, block)
+}
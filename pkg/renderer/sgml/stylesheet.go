@@ -0,0 +1,896 @@
+package sgml
+
+// DefaultStylesheetName the name of the default stylesheet, when it is linked to the document (`linkcss` attribute)
+const DefaultStylesheetName = "libasciidoc.css"
+
+// DefaultStylesheet the default stylesheet of the HTML documents, which styles the elements
+// with the class names used in the `html5` and `xhtml5` templates
+const DefaultStylesheet = `/* libasciidoc default stylesheet */
+html {
+  font-size: 100%;
+  -webkit-text-size-adjust: 100%;
+}
+*, *::before, *::after {
+  box-sizing: border-box;
+}
+body {
+  margin: 0;
+  padding: 0;
+  background: #fff;
+  color: rgba(0, 0, 0, .8);
+  font-family: "Noto Serif", "DejaVu Serif", Georgia, serif;
+  font-size: 1.0625rem;
+  line-height: 1.6;
+  word-wrap: break-word;
+}
+a {
+  color: #2156a5;
+  text-decoration: underline;
+}
+a:hover, a:focus {
+  color: #1d4b8f;
+}
+a img {
+  border: 0;
+}
+img, object, svg {
+  display: inline-block;
+  max-width: 100%;
+  height: auto;
+  vertical-align: middle;
+}
+h1, h2, h3, h4, h5, h6, #toctitle, .sidebarblock > .content > .title {
+  margin-top: 1em;
+  margin-bottom: .5em;
+  color: #ba3925;
+  font-family: "Open Sans", "DejaVu Sans", sans-serif;
+  font-weight: 300;
+  line-height: 1.2;
+  word-spacing: -.05em;
+}
+h1 {
+  font-size: 2.125em;
+}
+h2 {
+  font-size: 1.6875em;
+}
+h3, #toctitle, .sidebarblock > .content > .title {
+  font-size: 1.375em;
+}
+h4, h5 {
+  font-size: 1.125em;
+}
+h6 {
+  font-size: 1em;
+}
+p {
+  margin: 0 0 1.25em;
+  line-height: 1.6;
+  text-rendering: optimizeLegibility;
+}
+strong, b {
+  font-weight: bold;
+}
+code, kbd, pre {
+  font-family: "Droid Sans Mono", "DejaVu Sans Mono", monospace;
+}
+:not(pre) > code {
+  padding: .1em .5ex;
+  border-radius: 4px;
+  background: #f7f7f8;
+  color: rgba(0, 0, 0, .9);
+  font-size: .9375em;
+  word-spacing: -.15em;
+}
+pre {
+  margin: 0;
+  line-height: 1.45;
+  white-space: pre-wrap;
+  overflow-wrap: break-word;
+}
+mark {
+  background: #ffff00;
+}
+hr {
+  height: 0;
+  margin: 1.25em 0 1.1875em;
+  border: solid #dddddf;
+  border-width: 1px 0 0;
+}
+abbr[title] {
+  border-bottom: 1px dotted;
+  cursor: help;
+  text-decoration: none;
+}
+ul, ol, dl {
+  margin: 0 0 1.25em 1.5em;
+  padding: 0;
+  line-height: 1.6;
+}
+ul li ul, ul li ol, ol li ul, ol li ol {
+  margin-bottom: 0;
+  margin-left: 1.25em;
+}
+dl dt {
+  margin-bottom: .3125em;
+  font-weight: bold;
+}
+dl dd {
+  margin-bottom: 1.25em;
+  margin-left: 1.125em;
+}
+table {
+  margin-bottom: 1.25em;
+  border: 1px solid #dedede;
+  border-collapse: collapse;
+  border-spacing: 0;
+  background: #fff;
+}
+table tr th, table tr td {
+  padding: .5625em .625em;
+  color: rgba(0, 0, 0, .8);
+  font-size: inherit;
+  line-height: 1.6;
+}
+
+/* layout */
+#header, #content, #footnotes, #footer {
+  position: relative;
+  max-width: 62.5em;
+  margin: 0 auto;
+  padding: 0 1em;
+}
+#header::after, #content::after, #footnotes::after, #footer::after {
+  content: " ";
+  display: table;
+  clear: both;
+}
+#content {
+  margin-top: 1.25em;
+}
+#content::before {
+  content: none;
+}
+#header > h1:first-child {
+  margin-top: 2.25rem;
+  margin-bottom: 0;
+  color: rgba(0, 0, 0, .85);
+}
+#header > h1:first-child + #toc {
+  margin-top: 8px;
+  border-top: 1px solid #dddddf;
+}
+#header .details {
+  display: flex;
+  flex-flow: row wrap;
+  padding-top: .25em;
+  padding-bottom: .25em;
+  border-bottom: 1px solid #dddddf;
+  color: rgba(0, 0, 0, .6);
+  line-height: 1.45;
+}
+#header .details span:first-child {
+  margin-left: -.125em;
+}
+#header .details span.email a {
+  color: rgba(0, 0, 0, .85);
+}
+#header .details br {
+  display: none;
+}
+#header .details br + span::before {
+  content: "\00a0\2013\00a0";
+}
+#header .details br + span.author::before {
+  content: "\00a0\22c5\00a0";
+  color: rgba(0, 0, 0, .85);
+}
+#header .details br + span#revremark::before {
+  content: "\00a0|\00a0";
+}
+#footer {
+  max-width: none;
+  padding: 1.25em;
+  background: rgba(0, 0, 0, .8);
+}
+#footer-text {
+  color: rgba(255, 255, 255, .8);
+  line-height: 1.44;
+}
+.sect0 {
+  page-break-before: always;
+}
+h1.sect0 {
+  font-size: 2.25em;
+  text-align: center;
+}
+.sect1 {
+  padding-bottom: .625em;
+}
+.sect1 + .sect1 {
+  border-top: 1px solid #e7e7e9;
+}
+h1 > a.anchor, h2 > a.anchor, h3 > a.anchor, h4 > a.anchor, h5 > a.anchor, h6 > a.anchor {
+  position: absolute;
+  z-index: 1001;
+  width: 1.5ex;
+  margin-left: -1.5ex;
+  display: block;
+  visibility: hidden;
+  text-align: center;
+  text-decoration: none;
+  font-weight: 400;
+}
+h1 > a.anchor::before, h2 > a.anchor::before, h3 > a.anchor::before, h4 > a.anchor::before, h5 > a.anchor::before, h6 > a.anchor::before {
+  content: "\00A7";
+  font-size: .85em;
+}
+h1:hover > a.anchor, h2:hover > a.anchor, h3:hover > a.anchor, h4:hover > a.anchor, h5:hover > a.anchor, h6:hover > a.anchor {
+  visibility: visible;
+}
+
+/* table of contents */
+#toc {
+  margin-bottom: 1.25em;
+  padding-bottom: .5em;
+  border-bottom: 1px solid #e7e7e9;
+}
+#toc > ul {
+  margin-left: .125em;
+}
+#toc ul.sectlevel0 > li > a {
+  font-style: italic;
+}
+#toc ul.sectlevel0 ul.sectlevel1 {
+  margin: .5em 0;
+}
+#toc ul {
+  list-style-type: none;
+  font-family: "Open Sans", "DejaVu Sans", sans-serif;
+}
+#toc li {
+  margin-top: .3334em;
+  line-height: 1.3334;
+}
+#toc a {
+  text-decoration: none;
+}
+#toc a:active {
+  text-decoration: underline;
+}
+#toctitle {
+  color: #7a2518;
+  font-size: 1.2em;
+}
+
+/* blocks */
+.paragraph.lead > p, #preamble > .sectionbody > [class="paragraph"]:first-of-type p {
+  font-size: 1.21875em;
+  line-height: 1.6;
+}
+.title, caption.title, .tableblock > caption {
+  margin-top: 0;
+  margin-bottom: .25em;
+  color: #7a2518;
+  font-family: "Noto Serif", "DejaVu Serif", serif;
+  font-style: italic;
+  font-weight: 400;
+  line-height: 1.45;
+  text-align: left;
+  text-rendering: optimizeLegibility;
+}
+.paragraph, .admonitionblock, .exampleblock, .sidebarblock, .listingblock, .literalblock, .stemblock,
+.openblock, .quoteblock, .verseblock, .imageblock, .videoblock, .audioblock, .ulist, .olist, .dlist, .colist, .hdlist, .qlist {
+  margin-bottom: 1.25em;
+}
+.admonitionblock td.content > .title, .audioblock > .title, .exampleblock > .title, .imageblock > .title,
+.listingblock > .title, .literalblock > .title, .stemblock > .title, .openblock > .title, .paragraph > .title,
+.quoteblock > .title, .sidebarblock > .title, .verseblock > .title, .videoblock > .title, .dlist > .title,
+.olist > .title, .ulist > .title, .qlist > .title, .hdlist > .title, .colist > .title {
+  text-rendering: optimizeLegibility;
+  text-align: left;
+  font-size: 1rem;
+  font-style: italic;
+}
+.admonitionblock > table {
+  width: 100%;
+  border: 0;
+  border-collapse: separate;
+  background: none;
+}
+.admonitionblock > table td.icon {
+  width: 80px;
+  text-align: center;
+}
+.admonitionblock > table td.icon img {
+  max-width: none;
+}
+.admonitionblock > table td.icon .title {
+  font-family: "Open Sans", "DejaVu Sans", sans-serif;
+  font-weight: bold;
+  text-transform: uppercase;
+}
+.admonitionblock > table td.icon .fa {
+  font-size: 2.5em;
+  cursor: default;
+}
+.admonitionblock.note td.icon .fa {
+  color: #19407c;
+}
+.admonitionblock.tip td.icon .fa {
+  color: #111;
+}
+.admonitionblock.warning td.icon .fa {
+  color: #bf6900;
+}
+.admonitionblock.caution td.icon .fa {
+  color: #bf3400;
+}
+.admonitionblock.important td.icon .fa {
+  color: #bf0000;
+}
+.admonitionblock > table td.content {
+  padding-right: 1.25em;
+  padding-left: 1.125em;
+  border-left: 1px solid #dddddf;
+  color: rgba(0, 0, 0, .6);
+  word-wrap: anywhere;
+}
+.admonitionblock > table td.content > :last-child > :last-child {
+  margin-bottom: 0;
+}
+.exampleblock > .content {
+  padding: 1.25em;
+  border: 1px solid #e6e6e6;
+  border-radius: 4px;
+  background: #fff;
+}
+.sidebarblock {
+  padding: 1.25em;
+  border: 1px solid #dbdbd6;
+  border-radius: 4px;
+  background: #f3f3f2;
+}
+.sidebarblock > .content > .title {
+  margin-top: 0;
+  text-align: center;
+}
+.exampleblock > .content > :first-child, .sidebarblock > .content > :first-child {
+  margin-top: 0;
+}
+.exampleblock > .content > :last-child, .exampleblock > .content > :last-child > :last-child,
+.sidebarblock > .content > :last-child, .sidebarblock > .content > :last-child > :last-child {
+  margin-bottom: 0;
+}
+.literalblock pre, .listingblock > .content > pre {
+  padding: 1em;
+  border-radius: 4px;
+  background: #f7f7f8;
+  font-size: .8125em;
+  overflow-x: auto;
+}
+.listingblock > .content {
+  position: relative;
+}
+.listingblock code[data-lang]::before {
+  content: attr(data-lang);
+  position: absolute;
+  top: .425rem;
+  right: .5rem;
+  display: none;
+  color: inherit;
+  font-size: .75em;
+  line-height: 1;
+  text-transform: uppercase;
+  opacity: .5;
+}
+.listingblock:hover code[data-lang]::before {
+  display: block;
+}
+.quoteblock {
+  margin: 0 1em 1.25em 1.5em;
+  display: table;
+}
+.quoteblock > .title {
+  margin-left: -1.5em;
+  margin-bottom: .75em;
+}
+.quoteblock blockquote, .verseblock pre {
+  margin: 0;
+  padding: 0;
+  border: 0;
+  color: rgba(0, 0, 0, .85);
+  font-size: 1.15rem;
+  line-height: 1.75;
+  word-spacing: .1em;
+  letter-spacing: 0;
+  font-style: italic;
+  text-align: justify;
+}
+.verseblock pre {
+  font-family: "Open Sans", "DejaVu Sans", sans-serif;
+  white-space: pre-wrap;
+}
+.quoteblock blockquote {
+  margin: 0;
+  padding: 0;
+  border: 0;
+}
+.quoteblock blockquote::before {
+  content: "\201c";
+  float: left;
+  margin-left: -.6em;
+  color: #7a2518;
+  font-size: 2.75em;
+  font-weight: bold;
+  line-height: .6em;
+  text-shadow: 0 1px 2px rgba(0, 0, 0, .1);
+}
+.quoteblock blockquote > .paragraph:last-child p {
+  margin-bottom: 0;
+}
+.quoteblock .attribution, .verseblock .attribution {
+  margin-top: .75em;
+  margin-right: .5ex;
+  text-align: right;
+  font-size: .9375em;
+  line-height: 1.45;
+  font-style: italic;
+}
+.quoteblock .attribution br, .verseblock .attribution br {
+  display: none;
+}
+.quoteblock .attribution cite, .verseblock .attribution cite {
+  display: block;
+  color: rgba(0, 0, 0, .6);
+  letter-spacing: -.025em;
+}
+.quoteblock.abstract blockquote::before, .quoteblock.excerpt blockquote::before, .quoteblock .quoteblock blockquote::before {
+  display: none;
+}
+.quoteblock.abstract {
+  margin: 0 1em 1.25em;
+  display: block;
+}
+.quoteblock.abstract > .title {
+  margin: 0 0 .375em;
+  font-size: 1.15em;
+  text-align: center;
+}
+.stemblock > .content {
+  text-align: center;
+}
+.imageblock, .videoblock {
+  max-width: 100%;
+}
+.imageblock.left {
+  margin: .25em .625em 1.25em 0;
+}
+.imageblock.right {
+  margin: .25em 0 1.25em .625em;
+}
+.imageblock > .title {
+  margin-bottom: 0;
+}
+.imageblock.text-center, .imageblock.center, .videoblock.center {
+  text-align: center;
+}
+.imageblock.text-right, .videoblock.right {
+  text-align: right;
+}
+.image.left, .imageblock.left {
+  float: left;
+}
+.image.right, .imageblock.right {
+  float: right;
+}
+span.image.left {
+  margin-right: .625em;
+}
+span.image.right {
+  margin-left: .625em;
+}
+.videoblock > .content > iframe, .videoblock > .content > video {
+  max-width: 100%;
+}
+.openblock > .content > :last-child {
+  margin-bottom: 0;
+}
+
+/* lists */
+ul li p, ol li p {
+  margin-bottom: .625em;
+}
+.ulist > ul, .olist > ol {
+  margin-left: 1.5em;
+}
+ul.checklist, ul.none, ol.none, ul.no-bullet, ol.no-bullet, ol.unnumbered, ul.unstyled, ol.unstyled {
+  margin-left: .625em;
+  list-style-type: none;
+}
+ul.checklist li > p:first-child > .fa-square-o:first-child, ul.checklist li > p:first-child > .fa-check-square-o:first-child {
+  width: 1.25em;
+  font-size: .8em;
+  position: relative;
+  bottom: .125em;
+}
+ul.checklist li > p:first-child > input[type="checkbox"]:first-child {
+  margin-right: .25em;
+}
+ul.disc {
+  list-style-type: disc;
+}
+ul.square {
+  list-style-type: square;
+}
+ul.circle {
+  list-style-type: circle;
+}
+ol.arabic {
+  list-style-type: decimal;
+}
+ol.decimal {
+  list-style-type: decimal-leading-zero;
+}
+ol.loweralpha {
+  list-style-type: lower-alpha;
+}
+ol.upperalpha {
+  list-style-type: upper-alpha;
+}
+ol.lowerroman {
+  list-style-type: lower-roman;
+}
+ol.upperroman {
+  list-style-type: upper-roman;
+}
+ol.lowergreek {
+  list-style-type: lower-greek;
+}
+.dlist dl dd:last-child {
+  margin-bottom: 0;
+}
+.hdlist > table, .colist > table {
+  border: 0;
+  background: none;
+}
+.hdlist > table > tbody > tr, .colist > table > tbody > tr {
+  background: none;
+}
+td.hdlist1, td.hdlist2 {
+  padding: 0 .625em;
+  vertical-align: top;
+}
+td.hdlist1 {
+  padding-left: 0;
+  padding-right: .75em;
+  font-weight: bold;
+}
+.qlist > ol > li > p:first-child {
+  font-style: italic;
+}
+.colist td:not([class]):first-child {
+  width: 1.25em;
+  padding: .4em .75em 0;
+  vertical-align: top;
+  line-height: 1;
+}
+.colist td:not([class]):first-child img {
+  max-width: none;
+}
+.colist td:not([class]):last-child {
+  padding: .25em 0;
+}
+.conum[data-value] {
+  display: inline-block;
+  width: 1.67em;
+  height: 1.67em;
+  border-radius: 50%;
+  background: rgba(0, 0, 0, .8);
+  color: #fff !important;
+  font-family: "Open Sans", "DejaVu Sans", sans-serif;
+  font-size: .75em;
+  font-style: normal;
+  font-weight: bold;
+  line-height: 1.67em;
+  text-align: center;
+}
+.conum[data-value] * {
+  color: #fff !important;
+}
+.conum[data-value] + b {
+  display: none;
+}
+.conum[data-value]::after {
+  content: attr(data-value);
+}
+pre .conum[data-value] {
+  position: relative;
+  top: -.125em;
+}
+b.conum * {
+  color: inherit !important;
+}
+.conum:not([data-value]):empty {
+  display: none;
+}
+ul.bibliography {
+  margin-left: 0;
+  list-style-type: none;
+}
+ul.bibliography > li {
+  margin-bottom: .5em;
+}
+.index ul {
+  margin-left: 0;
+  list-style-type: none;
+}
+.index ul ul {
+  margin-left: 1.25em;
+}
+.indexgroup h3 {
+  margin-bottom: .25em;
+}
+
+/* tables */
+table.tableblock {
+  max-width: 100%;
+  border-collapse: separate;
+}
+p.tableblock:last-child {
+  margin-bottom: 0;
+}
+td.tableblock > .content {
+  margin-bottom: 1.25em;
+  word-wrap: anywhere;
+}
+td.tableblock > .content > :last-child {
+  margin-bottom: -1.25em;
+}
+table.tableblock, th.tableblock, td.tableblock {
+  border: 0 solid #dedede;
+}
+table.grid-all > * > tr > * {
+  border-width: 1px;
+}
+table.grid-cols > * > tr > * {
+  border-width: 0 1px;
+}
+table.grid-rows > * > tr > * {
+  border-width: 1px 0;
+}
+table.frame-all {
+  border-width: 1px;
+}
+table.frame-ends {
+  border-width: 1px 0;
+}
+table.frame-sides {
+  border-width: 0 1px;
+}
+table.frame-none > colgroup + * > :first-child > *, table.frame-sides > colgroup + * > :first-child > * {
+  border-top-width: 0;
+}
+table.frame-none > :last-child > :last-child > *, table.frame-sides > :last-child > :last-child > * {
+  border-bottom-width: 0;
+}
+table.frame-none > * > tr > :first-child, table.frame-ends > * > tr > :first-child {
+  border-left-width: 0;
+}
+table.frame-none > * > tr > :last-child, table.frame-ends > * > tr > :last-child {
+  border-right-width: 0;
+}
+table.stripes-all > * > tr, table.stripes-odd > * > tr:nth-of-type(odd), table.stripes-even > * > tr:nth-of-type(even),
+table.stripes-hover > * > tr:hover {
+  background: #f8f8f7;
+}
+table.stretch {
+  width: 100%;
+}
+table.fit-content {
+  width: auto;
+}
+table.tableblock.left {
+  float: left;
+  margin-right: 1.25em;
+}
+table.tableblock.right {
+  float: right;
+  margin-left: 1.25em;
+}
+th.halign-left, td.halign-left {
+  text-align: left;
+}
+th.halign-right, td.halign-right {
+  text-align: right;
+}
+th.halign-center, td.halign-center {
+  text-align: center;
+}
+th.valign-top, td.valign-top {
+  vertical-align: top;
+}
+th.valign-bottom, td.valign-bottom {
+  vertical-align: bottom;
+}
+th.valign-middle, td.valign-middle {
+  vertical-align: middle;
+}
+table thead th, table tfoot td {
+  font-weight: bold;
+}
+table tfoot {
+  background: #f7f8f7;
+}
+
+/* inline elements */
+.menuseq, .menuref {
+  color: #000;
+}
+.menuseq b:not(.caret), .menuref {
+  font-weight: inherit;
+}
+.menuseq {
+  word-spacing: -.02em;
+}
+.menuseq b.caret {
+  font-size: 1.25em;
+  line-height: .8;
+}
+.menuseq i.caret {
+  font-weight: bold;
+  text-align: center;
+  width: .45em;
+}
+b.button::before, b.button::after {
+  position: relative;
+  top: -1px;
+  font-weight: 400;
+}
+b.button::before {
+  content: "[";
+  padding: 0 3px 0 2px;
+}
+b.button::after {
+  content: "]";
+  padding: 0 2px 0 3px;
+}
+kbd {
+  display: inline-block;
+  margin: 0 .15em;
+  padding: .2em .5em;
+  border: 1px solid #ccc;
+  border-radius: 3px;
+  background: #f7f7f7;
+  box-shadow: 0 1px 0 rgba(0, 0, 0, .2), 0 0 0 .1em #fff inset;
+  color: rgba(0, 0, 0, .8);
+  font-size: .65em;
+  line-height: 1.45;
+  white-space: nowrap;
+}
+span.icon > .fa {
+  cursor: default;
+}
+a span.icon > .fa {
+  cursor: inherit;
+}
+svg.fa {
+  display: inline-block;
+  vertical-align: -.125em;
+}
+.big {
+  font-size: larger;
+}
+.small {
+  font-size: smaller;
+}
+.underline {
+  text-decoration: underline;
+}
+.overline {
+  text-decoration: overline;
+}
+.line-through {
+  text-decoration: line-through;
+}
+.text-left {
+  text-align: left !important;
+}
+.text-right {
+  text-align: right !important;
+}
+.text-center {
+  text-align: center !important;
+}
+.text-justify {
+  text-align: justify !important;
+}
+.nowrap {
+  white-space: nowrap;
+}
+.red {
+  color: #bf0000;
+}
+.green {
+  color: #00bf00;
+}
+.blue {
+  color: #0000bf;
+}
+.yellow {
+  color: #bfbf00;
+}
+
+/* footnotes */
+.footnote, .footnoteref {
+  vertical-align: super;
+  font-size: .875em;
+}
+.footnote a, .footnoteref a {
+  text-decoration: none;
+}
+#footnotes {
+  padding-top: .75em;
+  padding-bottom: .75em;
+  margin-bottom: .625em;
+}
+#footnotes hr {
+  width: 20%;
+  min-width: 6.25em;
+  margin: -.25em 0 .75em;
+  border-width: 1px 0 0;
+}
+#footnotes .footnote {
+  margin-bottom: .2em;
+  margin-left: 1.2em;
+  padding: 0 .375em 0 .225em;
+  font-size: .875em;
+  line-height: 1.3334;
+  vertical-align: baseline;
+}
+#footnotes .footnote a:first-of-type {
+  margin-left: -1.05em;
+  font-weight: bold;
+  text-decoration: none;
+}
+#footnotes .footnote:last-of-type {
+  margin-bottom: 0;
+}
+
+/* source highlighting */
+pre.highlight > code, pre.chroma > code, pre.pygments > code {
+  display: block;
+}
+
+@media print {
+  @page {
+    margin: 1.25cm .75cm;
+  }
+  * {
+    box-shadow: none !important;
+    text-shadow: none !important;
+  }
+  html {
+    font-size: 80%;
+  }
+  a {
+    color: inherit !important;
+    text-decoration: underline !important;
+  }
+  pre, blockquote, tr, img, object, svg {
+    page-break-inside: avoid;
+  }
+  h2, h3, #toctitle, .sidebarblock > .content > .title {
+    page-break-after: avoid;
+  }
+  #toc, .sidebarblock, .exampleblock > .content {
+    background: none !important;
+  }
+  #footer {
+    padding: 0 .9375em;
+    background: none;
+  }
+  #footer-text {
+    color: rgba(0, 0, 0, .6);
+  }
+}`
@@ -125,10 +125,9 @@ func (r *sgmlRenderer) renderTableHeader(ctx *context, h *types.TableRow, cols [
 		return "", nil
 	}
 	content := &strings.Builder{}
-	col := 0
-	for _, cell := range h.Cells {
-		c, err := r.renderTableHeaderCell(ctx, cell, cols[col%len(cols)])
-		col++
+	positions := cellColumns([]*types.TableRow{h}, len(cols))[0]
+	for i, cell := range h.Cells {
+		c, err := r.renderTableHeaderCell(ctx, cell, cols, positions[i])
 		if err != nil {
 			return "", errors.Wrap(err, "unable to render header")
 		}
@@ -141,7 +140,8 @@ func (r *sgmlRenderer) renderTableHeader(ctx *context, h *types.TableRow, cols [
 	})
 }
 
-func (r *sgmlRenderer) renderTableHeaderCell(ctx *context, c *types.TableCell, col *types.TableColumn) (string, error) {
+func (r *sgmlRenderer) renderTableHeaderCell(ctx *context, c *types.TableCell, cols []*types.TableColumn, position int) (string, error) {
+	col := cols[position%len(cols)]
 	// assume that elements to render are within the first element of the cell, which should be a paragraph
 	if len(c.Elements) == 1 {
 		if p, ok := c.Elements[0].(*types.Paragraph); ok {
@@ -149,14 +149,25 @@ func (r *sgmlRenderer) renderTableHeaderCell(ctx *context, c *types.TableCell, c
 			if err != nil {
 				return "", errors.Wrap(err, "unable to render header cell")
 			}
+			halign, valign := cellAlignments(c, col)
 			return r.execute(r.tableHeaderCell, struct {
-				HAlign  types.HAlign
-				VAlign  types.VAlign
-				Content string
+				HAlign     types.HAlign
+				VAlign     types.VAlign
+				Column     int
+				LastColumn int
+				ColSpan    int
+				RowSpan    int
+				MoreRows   int
+				Content    string
 			}{
-				HAlign:  col.HAlign,
-				VAlign:  col.VAlign,
-				Content: content,
+				HAlign:     halign,
+				VAlign:     valign,
+				Column:     position,
+				LastColumn: position + c.ColSpan() - 1,
+				ColSpan:    c.ColSpan(),
+				RowSpan:    c.RowSpan(),
+				MoreRows:   c.RowSpan() - 1,
+				Content:    content,
 			})
 		}
 	}
@@ -168,10 +179,9 @@ func (r *sgmlRenderer) renderTableFooter(ctx *context, f *types.TableRow, cols [
 		return "", nil
 	}
 	content := &strings.Builder{}
-	col := 0
-	for _, cell := range f.Cells {
-		c, err := r.renderTableFooterCell(ctx, cell, cols[col%len(cols)])
-		col++
+	positions := cellColumns([]*types.TableRow{f}, len(cols))[0]
+	for i, cell := range f.Cells {
+		c, err := r.renderTableFooterCell(ctx, cell, cols, positions[i])
 		if err != nil {
 			return "", errors.Wrap(err, "unable to render footer")
 		}
@@ -188,7 +198,8 @@ func (r *sgmlRenderer) renderTableFooter(ctx *context, f *types.TableRow, cols [
 	})
 }
 
-func (r *sgmlRenderer) renderTableFooterCell(ctx *context, c *types.TableCell, col *types.TableColumn) (string, error) {
+func (r *sgmlRenderer) renderTableFooterCell(ctx *context, c *types.TableCell, cols []*types.TableColumn, position int) (string, error) {
+	col := cols[position%len(cols)]
 	// assume that elements to render are within the first element of the cell, which should be a paragraph
 	if len(c.Elements) == 1 {
 		if p, ok := c.Elements[0].(*types.Paragraph); ok {
@@ -196,14 +207,25 @@ func (r *sgmlRenderer) renderTableFooterCell(ctx *context, c *types.TableCell, c
 			if err != nil {
 				return "", errors.Wrap(err, "unable to render footer cell")
 			}
+			halign, valign := cellAlignments(c, col)
 			return r.execute(r.tableFooterCell, struct {
-				HAlign  types.HAlign
-				VAlign  types.VAlign
-				Content string
+				HAlign     types.HAlign
+				VAlign     types.VAlign
+				Column     int
+				LastColumn int
+				ColSpan    int
+				RowSpan    int
+				MoreRows   int
+				Content    string
 			}{
-				HAlign:  col.HAlign,
-				VAlign:  col.VAlign,
-				Content: content,
+				HAlign:     halign,
+				VAlign:     valign,
+				Column:     position,
+				LastColumn: position + c.ColSpan() - 1,
+				ColSpan:    c.ColSpan(),
+				RowSpan:    c.RowSpan(),
+				MoreRows:   c.RowSpan() - 1,
+				Content:    content,
 			})
 		}
 	}
@@ -212,8 +234,9 @@ func (r *sgmlRenderer) renderTableFooterCell(ctx *context, c *types.TableCell, c
 
 func (r *sgmlRenderer) renderTableBody(ctx *context, rows []*types.TableRow, columns []*types.TableColumn) (string, error) {
 	content := &strings.Builder{}
-	for _, row := range rows {
-		c, err := r.renderTableRow(ctx, row, columns)
+	positions := cellColumns(rows, len(columns))
+	for i, row := range rows {
+		c, err := r.renderTableRow(ctx, row, columns, positions[i])
 		if err != nil {
 			return "", errors.Wrap(err, "unable to render body")
 		}
@@ -232,10 +255,10 @@ func (r *sgmlRenderer) renderTableBody(ctx *context, rows []*types.TableRow, col
 	})
 }
 
-func (r *sgmlRenderer) renderTableRow(ctx *context, l *types.TableRow, cols []*types.TableColumn) (string, error) {
+func (r *sgmlRenderer) renderTableRow(ctx *context, l *types.TableRow, cols []*types.TableColumn, positions []int) (string, error) {
 	content := &strings.Builder{}
 	for i, cell := range l.Cells {
-		c, err := r.renderTableCell(ctx, cell, cols[i])
+		c, err := r.renderTableCell(ctx, cell, cols, positions[i])
 		if err != nil {
 			return "", errors.Wrap(err, "unable to render row")
 		}
@@ -252,39 +275,97 @@ func (r *sgmlRenderer) renderTableRow(ctx *context, l *types.TableRow, cols []*t
 	})
 }
 
-func (r *sgmlRenderer) renderTableCell(ctx *context, cell *types.TableCell, col *types.TableColumn) (string, error) {
+func (r *sgmlRenderer) renderTableCell(ctx *context, cell *types.TableCell, cols []*types.TableColumn, position int) (string, error) {
+	col := cols[position%len(cols)]
+	// the style of the cell takes precedence over the style of the column
+	style := types.ContentStyle(cell.Format)
+	if style == "" {
+		style = col.Style
+	}
 	buff := &strings.Builder{}
-	for _, element := range cell.Elements {
-		renderedElement, err := r.renderTableCellBlock(ctx, element)
+	if style == types.AsciidocStyle {
+		// AsciiDoc content is rendered as a standalone document (which may include a nested table)
+		content, err := r.renderElements(ctx, cell.Elements)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render table cell")
+		}
+		block, err := r.execute(r.tableCellBlock, struct {
+			Content string
+		}{
+			Content: content,
+		})
 		if err != nil {
-			return "", err
+			return "", errors.Wrap(err, "unable to render table cell")
+		}
+		buff.WriteString(block)
+	} else {
+		for _, element := range cell.Elements {
+			renderedElement, err := r.renderTableCellBlock(ctx, element, style)
+			if err != nil {
+				return "", err
+			}
+			buff.WriteString(renderedElement)
 		}
-		buff.WriteString(renderedElement)
 	}
 	tmpl := r.tableCell
-	if col.Style == types.HeaderStyle {
+	if style == types.HeaderStyle {
 		tmpl = r.tableHeaderCell
 	}
+	halign, valign := cellAlignments(cell, col)
 	return r.execute(tmpl, struct {
-		Context *context
-		Content string
-		Cell    *types.TableCell
-		HAlign  types.HAlign
-		VAlign  types.VAlign
+		Context    *context
+		Content    string
+		Cell       *types.TableCell
+		HAlign     types.HAlign
+		VAlign     types.VAlign
+		Column     int
+		LastColumn int
+		ColSpan    int
+		RowSpan    int
+		MoreRows   int
 	}{
-		Context: ctx,
-		Content: buff.String(),
-		Cell:    cell,
-		HAlign:  col.HAlign,
-		VAlign:  col.VAlign,
+		Context:    ctx,
+		Content:    buff.String(),
+		Cell:       cell,
+		HAlign:     halign,
+		VAlign:     valign,
+		Column:     position,
+		LastColumn: position + cell.ColSpan() - 1,
+		ColSpan:    cell.ColSpan(),
+		RowSpan:    cell.RowSpan(),
+		MoreRows:   cell.RowSpan() - 1,
 	})
 }
 
-func (r *sgmlRenderer) renderTableCellBlock(ctx *context, element interface{}) (string, error) {
+func (r *sgmlRenderer) renderTableCellBlock(ctx *context, element interface{}, style types.ContentStyle) (string, error) {
 	switch e := element.(type) {
+	case *types.DelimitedBlock:
+		if e.Kind != types.Literal {
+			return r.renderTableCellElement(ctx, e)
+		}
+		log.Debug("rendering literal block within table cell")
+		content, err := r.renderElements(ctx, e.Elements)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render table cell literal content")
+		}
+		return r.execute(r.tableCellLiteral, struct {
+			Content string
+		}{
+			Content: strings.Trim(content, "\n"),
+		})
 	case *types.Paragraph:
 		log.Debug("rendering paragraph within table cell")
-		content, err := r.renderElements(ctx, e.Elements)
+		elements := e.Elements
+		// emphasis, monospace and strong styles apply on the whole content of the cell
+		switch style {
+		case types.EmphasisStyle:
+			elements = []interface{}{&types.QuotedText{Kind: types.SingleQuoteItalic, Elements: elements}}
+		case types.MonospaceStyle:
+			elements = []interface{}{&types.QuotedText{Kind: types.SingleQuoteMonospace, Elements: elements}}
+		case types.StrongStyle:
+			elements = []interface{}{&types.QuotedText{Kind: types.SingleQuoteBold, Elements: elements}}
+		}
+		content, err := r.renderElements(ctx, elements)
 		if err != nil {
 			return "", errors.Wrap(err, "unable to render table cell paragraph content")
 		}
@@ -312,15 +393,60 @@ func (r *sgmlRenderer) renderTableCellBlock(ctx *context, element interface{}) (
 		}
 		return strings.TrimSuffix(result, "\n"), nil
 	default:
-		// Note: Asciidoctor wraps the `<div class=imageblock>` elements within a `<div class="content">`, which we also do here for the sake of compatibility
-		renderedElement, err := r.renderElement(ctx, e)
-		if err != nil {
-			return "", errors.Wrap(err, "unable to render table cell")
+		return r.renderTableCellElement(ctx, e)
+	}
+}
+
+func (r *sgmlRenderer) renderTableCellElement(ctx *context, element interface{}) (string, error) {
+	// Note: Asciidoctor wraps the `<div class=imageblock>` elements within a `<div class="content">`, which we also do here for the sake of compatibility
+	renderedElement, err := r.renderElement(ctx, element)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render table cell")
+	}
+	return r.execute(r.tableCellBlock, struct {
+		Content string
+	}{
+		Content: renderedElement,
+	})
+}
+
+// cellAlignments returns the horizontal and vertical alignments of the given cell,
+// which take precedence over the alignments of its column
+func cellAlignments(cell *types.TableCell, col *types.TableColumn) (types.HAlign, types.VAlign) {
+	halign, valign := col.HAlign, col.VAlign
+	if a, ok := cell.HAlign(); ok {
+		halign = a
+	}
+	if a, ok := cell.VAlign(); ok {
+		valign = a
+	}
+	return halign, valign
+}
+
+// cellColumns returns the index of the column in which each cell of the given rows starts,
+// taking into account the cells which span multiple columns and rows
+func cellColumns(rows []*types.TableRow, size int) [][]int {
+	result := make([][]int, len(rows))
+	// number of rows (including the current one) in which each column is occupied by a cell with a `rowspan`
+	spans := make([]int, size)
+	for i, row := range rows {
+		result[i] = make([]int, len(row.Cells))
+		col := 0
+		for j, c := range row.Cells {
+			for col < size && spans[col] > 0 {
+				col++
+			}
+			result[i][j] = col
+			for k := col; k < col+c.ColSpan() && k < size; k++ {
+				spans[k] = c.RowSpan()
+			}
+			col += c.ColSpan()
+		}
+		for k := range spans {
+			if spans[k] > 0 {
+				spans[k]--
+			}
 		}
-		return r.execute(r.tableCellBlock, struct {
-			Content string
-		}{
-			Content: renderedElement,
-		})
 	}
+	return result
 }
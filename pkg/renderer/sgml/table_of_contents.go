@@ -139,11 +139,13 @@ func (r *sgmlRenderer) renderTableOfContentsEntry(ctx *context, entry *types.ToC
 	}
 	return r.execute(r.tocEntry, struct {
 		Number  string
+		Caption string
 		ID      string
 		Title   string
 		Content string
 	}{
 		Number:  entry.Number,
+		Caption: ctx.sectionCaptions[entry.ID],
 		ID:      entry.ID,
 		Title:   entry.Title,
 		Content: content,
@@ -7,11 +7,15 @@ type Templates struct {
 	AdmonitionParagraph          string
 	Article                      string
 	ArticleHeader                string
+	AudioBlock                   string
+	BibliographyAnchor           string
+	BibliographyBlock            string
 	BlockImage                   string
 	BoldText                     string
 	CalloutList                  string
 	CalloutListElement           string
 	CalloutRef                   string
+	ConcealedIndexTerm           string
 	EmbeddedParagraph            string
 	DocumentDetails              string
 	DocumentAuthorDetails        string
@@ -24,11 +28,17 @@ type Templates struct {
 	Footnotes                    string
 	IconFont                     string
 	IconImage                    string
+	IconSVG                      string
 	IconText                     string
+	Index                        string
+	IndexEntry                   string
+	IndexTerm                    string
 	InlineButton                 string
+	InlineCitation               string
 	InlineIcon                   string
 	InlineImage                  string
 	InlineMenu                   string
+	InlineStem                   string
 	InternalCrossReference       string
 	InvalidFootnote              string
 	ItalicText                   string
@@ -59,12 +69,14 @@ type Templates struct {
 	SectionTitle                 string
 	SidebarBlock                 string
 	SourceBlock                  string
+	StemBlock                    string
 	SubscriptText                string
 	SuperscriptText              string
 	Table                        string
 	TableBody                    string
 	TableCell                    string
 	TableCellBlock               string
+	TableCellLiteral             string
 	TableHeader                  string
 	TableHeaderCell              string
 	TableFooter                  string
@@ -78,4 +90,5 @@ type Templates struct {
 	UnorderedListElement         string
 	VerseBlock                   string
 	VerseParagraph               string
+	VideoBlock                   string
 }
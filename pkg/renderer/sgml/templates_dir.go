@@ -0,0 +1,58 @@
+package sgml
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// TemplateFileExt the extension of the files which override the templates
+const TemplateFileExt = ".tmpl"
+
+// LoadTemplates returns the given templates, in which the templates are replaced by the content of the files
+// of the given directory named after the fields of `Templates` (eg: `Paragraph.tmpl` overrides the `Paragraph` template).
+// The other templates are left unchanged, and the files which do not match any template are ignored.
+// The trailing newline of each file is removed, and the content is passed to the given `wrap` func (if not nil),
+// as done for the built-in templates of the backend (eg: the `roff()` func of the manpage backend).
+func LoadTemplates(tmpls Templates, dir string, wrap func(string) string) (Templates, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return tmpls, errors.Wrap(err, "unable to load templates")
+	}
+	v := reflect.ValueOf(&tmpls).Elem()
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != TemplateFileExt {
+			continue
+		}
+		name := strings.TrimSuffix(f.Name(), TemplateFileExt)
+		field := v.FieldByName(name)
+		if !field.IsValid() || field.Kind() != reflect.String {
+			log.Warnf("ignoring template file '%s': there is no '%s' template", filepath.Join(dir, f.Name()), name)
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return tmpls, errors.Wrap(err, "unable to load templates")
+		}
+		log.Debugf("overriding the '%s' template with '%s'", name, filepath.Join(dir, f.Name()))
+		tmpl := trimTrailingNewline(string(content))
+		if wrap != nil {
+			tmpl = wrap(tmpl)
+		}
+		field.SetString(tmpl)
+	}
+	return tmpls, nil
+}
+
+// trimTrailingNewline removes the newline at the end of the given template file (if any), which is added by
+// most text editors, and which would otherwise be rendered after the element (eg: after an inline link)
+func trimTrailingNewline(tmpl string) string {
+	if strings.HasSuffix(tmpl, "\r\n") {
+		return strings.TrimSuffix(tmpl, "\r\n")
+	}
+	return strings.TrimSuffix(tmpl, "\n")
+}
@@ -10,7 +10,9 @@ const (
 		"{{ if .Generator }}<meta name=\"generator\" content=\"{{ .Generator }}\"/>\n{{ end }}" +
 		"{{ if .Authors }}<meta name=\"author\" content=\"{{ .Authors }}\"/>\n{{ end }}" +
 		"{{ range $css := .CSS }}<link type=\"text/css\" rel=\"stylesheet\" href=\"{{ $css }}\"/>\n{{ end }}" +
+		"{{ range $css := .EmbeddedCSS }}<style>\n{{ $css }}\n</style>\n{{ end }}" +
 		"<title>{{ .Title }}</title>\n" +
+		"{{ if .Docinfo }}{{ .Docinfo }}\n{{ end }}" +
 		"</head>\n" +
 		"<body" +
 		"{{ if .ID }} id=\"{{ .ID }}\"{{ end }}" +
@@ -25,6 +27,7 @@ const (
 		"Last updated {{ .LastUpdated }}\n" +
 		"</div>\n" +
 		"</div>\n{{ end }}" +
+		"{{ if .DocinfoFooter }}{{ .DocinfoFooter }}\n{{ end }}" +
 		"</body>\n" +
 		"</html>\n"
 )
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -30,6 +31,9 @@ v1.0, March 22, 2020: Containment
 <meta name="viewport" content="width=device-width, initial-scale=1.0"/>
 <meta name="generator" content="libasciidoc"/>
 <meta name="author" content="Xavier"/>
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -64,8 +68,10 @@ Last updated {{ .LastUpdated }}
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 
@@ -82,6 +88,9 @@ John Foo Doe <johndoe@example.com>; Jane Doe <janedoe@example.com>`
 <meta name="viewport" content="width=device-width, initial-scale=1.0"/>
 <meta name="generator" content="libasciidoc"/>
 <meta name="author" content="John Foo Doe; Jane Doe"/>
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -111,8 +120,10 @@ Last updated {{ .LastUpdated }}
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 	})
@@ -132,6 +143,9 @@ a paragraph`
 <meta http-equiv="X-UA-Compatible" content="IE=edge"/>
 <meta name="viewport" content="width=device-width, initial-scale=1.0"/>
 <meta name="generator" content="libasciidoc"/>
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -158,8 +172,10 @@ Last updated {{ .LastUpdated }}
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 
@@ -174,6 +190,9 @@ a paragraph`
 <meta http-equiv="X-UA-Compatible" content="IE=edge"/>
 <meta name="viewport" content="width=device-width, initial-scale=1.0"/>
 <meta name="generator" content="libasciidoc"/>
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -197,8 +216,10 @@ a paragraph`
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 
@@ -217,6 +238,9 @@ a paragraph`
 <meta name="viewport" content="width=device-width, initial-scale=1.0"/>
 <meta name="generator" content="libasciidoc"/>
 <meta name="author" content="Joe Blow"/>
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -246,8 +270,10 @@ a paragraph`
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 
@@ -266,6 +292,9 @@ a paragraph`
 <meta name="viewport" content="width=device-width, initial-scale=1.0"/>
 <meta name="generator" content="libasciidoc"/>
 <meta name="author" content="Joe Blow"/>
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -295,8 +324,10 @@ a paragraph`
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 
@@ -311,6 +342,9 @@ a paragraph`
 <meta http-equiv="X-UA-Compatible" content="IE=edge"/>
 <meta name="viewport" content="width=device-width, initial-scale=1.0"/>
 <meta name="generator" content="libasciidoc"/>
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -336,8 +370,10 @@ Last updated {{ .LastUpdated }}
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 
@@ -352,6 +388,9 @@ a paragraph`
 <meta http-equiv="X-UA-Compatible" content="IE=edge"/>
 <meta name="viewport" content="width=device-width, initial-scale=1.0"/>
 <meta name="generator" content="libasciidoc"/>
+<style>
+{{ .CSS }}
+</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -373,8 +412,10 @@ a paragraph`
 			)).To(MatchHTMLTemplate(expectedTmpl,
 				struct {
 					LastUpdated string
+					CSS         string
 				}{
 					LastUpdated: now.Format(configuration.LastUpdatedFormat),
+					CSS:         sgml.DefaultStylesheet,
 				}))
 		})
 	})
@@ -6,19 +6,31 @@ const (
 		" class=\"imageblock{{ if .Roles }} {{ .Roles }}{{ end }}\">\n" +
 		"<div class=\"content\">\n" +
 		`{{ if .Href }}<a class="image" href="{{ .Href }}">{{ end }}` +
-		`<img src="{{ .Src }}" alt="{{ .Alt }}"` +
+		svgImageTmpl +
+		`{{ else }}<img src="{{ .Src }}" alt="{{ .Alt }}"` +
 		`{{ if .Width }} width="{{ .Width }}"{{ end }}` +
 		`{{ if .Height }} height="{{ .Height }}"{{ end }}` +
-		"/>{{ if .Href }}</a>{{ end }}\n" +
+		"/>{{ end }}{{ if .Href }}</a>{{ end }}\n" +
 		"</div>\n" +
 		"{{ if .Title }}<div class=\"title\">{{ .Caption }}{{ .Title }}</div>\n{{ end }}" +
 		"</div>\n"
 
 	inlineImageTmpl = `<span class="image{{ if .Roles }} {{ .Roles }}{{ end }}">` +
 		`{{ if .Href }}<a class="image" href="{{ .Href }}">{{ end }}` +
-		`<img src="{{ .Src }}" alt="{{ .Alt }}"` +
+		svgImageTmpl +
+		`{{ else }}<img src="{{ .Src }}" alt="{{ .Alt }}"` +
 		`{{ if .Width }} width="{{ .Width }}"{{ end }}` +
 		`{{ if .Height }} height="{{ .Height }}"{{ end }}` +
 		`{{ if .Title }} title="{{ .Title }}"{{ end }}` +
-		`/>{{ if .Href }}</a>{{ end }}</span>`
+		`/>{{ end }}{{ if .Href }}</a>{{ end }}</span>`
+
+	// the inline SVG content or the interactive SVG object, to be followed by an `{{ else }}` branch for the regular image element
+	svgImageTmpl = `{{ if .SVG }}{{ .SVG }}` +
+		`{{ else if .Interactive }}<object type="image/svg+xml" data="{{ .Src }}"` +
+		`{{ if .Width }} width="{{ .Width }}"{{ end }}` +
+		`{{ if .Height }} height="{{ .Height }}"{{ end }}>` +
+		`{{ if .Fallback }}<img src="{{ .Fallback }}" alt="{{ .Alt }}"` +
+		`{{ if .Width }} width="{{ .Width }}"{{ end }}` +
+		`{{ if .Height }} height="{{ .Height }}"{{ end }}/>` +
+		`{{ else }}<span class="alt">{{ .Alt }}</span>{{ end }}</object>`
 )
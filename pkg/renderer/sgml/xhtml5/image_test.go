@@ -304,6 +304,22 @@ image::file:///bar/foo.png[]`
 <img src="file:///bar/foo.png" alt="foo"/>
 </div>
 </div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("svg", func() {
+
+		It("interactive block image with fallback", func() {
+			source := `:imagesdir: images
+
+image::circle.svg[Circle,100,opts=interactive,fallback=circle.png]`
+			expected := `<div class="imageblock">
+<div class="content">
+<object type="image/svg+xml" data="images/circle.svg" width="100"><img src="images/circle.png" alt="Circle" width="100"/></object>
+</div>
+</div>
 `
 			Expect(RenderXHTML(source)).To(MatchHTML(expected))
 		})
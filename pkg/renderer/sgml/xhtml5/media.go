@@ -0,0 +1,25 @@
+package xhtml5
+
+const (
+	// boolean attributes must have a value in XHTML
+	videoBlockTmpl = `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="videoblock{{ if .Roles }} {{ .Roles }}{{ end }}">
+{{ if .Title }}<div class="title">{{ .Title }}</div>
+{{ end }}<div class="content">
+{{ if .Service }}<iframe{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }} src="{{ .Src }}" frameborder="0"{{ if .FullScreen }} allowfullscreen="allowfullscreen"{{ end }}></iframe>
+{{ else }}<video src="{{ .Src }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ if .Poster }} poster="{{ .Poster }}"{{ end }}{{ if .Autoplay }} autoplay="autoplay"{{ end }}{{ if .Controls }} controls="controls"{{ end }}{{ if .Loop }} loop="loop"{{ end }}{{ if .Muted }} muted="muted"{{ end }}>
+Your browser does not support the video tag.
+</video>
+{{ end }}</div>
+</div>
+`
+
+	audioBlockTmpl = `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="audioblock{{ if .Roles }} {{ .Roles }}{{ end }}">
+{{ if .Title }}<div class="title">{{ .Title }}</div>
+{{ end }}<div class="content">
+<audio src="{{ .Src }}"{{ if .Autoplay }} autoplay="autoplay"{{ end }}{{ if .Controls }} controls="controls"{{ end }}{{ if .Loop }} loop="loop"{{ end }}>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>
+`
+)
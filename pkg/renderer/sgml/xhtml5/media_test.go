@@ -0,0 +1,48 @@
+package xhtml5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("videos and audios", func() {
+
+	It("video with options", func() {
+		source := `video::screencast.mp4[opts="autoplay,loop,muted"]`
+		expected := `<div class="videoblock">
+<div class="content">
+<video src="screencast.mp4" autoplay="autoplay" controls="controls" loop="loop" muted="muted">
+Your browser does not support the video tag.
+</video>
+</div>
+</div>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("video hosted on youtube", func() {
+		source := `video::rPQoq7ThGAU[youtube]`
+		expected := `<div class="videoblock">
+<div class="content">
+<iframe src="https://www.youtube.com/embed/rPQoq7ThGAU?rel=0" frameborder="0" allowfullscreen="allowfullscreen"></iframe>
+</div>
+</div>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("audio with options", func() {
+		source := `audio::podcast.mp3[opts=autoplay]`
+		expected := `<div class="audioblock">
+<div class="content">
+<audio src="podcast.mp3" autoplay="autoplay" controls="controls">
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+})
@@ -28,6 +28,8 @@ func Render(doc *types.Document, config *configuration.Configuration, output io.
 	templates.QuoteParagraph = quoteParagraphTmpl
 	templates.VerseBlock = verseBlockTmpl
 	templates.VerseParagraph = verseParagraphTmpl
+	templates.VideoBlock = videoBlockTmpl
+	templates.AudioBlock = audioBlockTmpl
 
 	return sgml.Render(doc, config, output, templates)
 }
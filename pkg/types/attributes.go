@@ -28,6 +28,10 @@ const (
 	AttrIDPrefix = "idprefix"
 	// DefaultIDPrefix the default ID Prefix
 	DefaultIDPrefix = "_"
+	// AttrOutFileSuffix the `outfilesuffix` attribute, the extension of the output files (eg: `.html`), also used in the cross references to other documents
+	AttrOutFileSuffix = "outfilesuffix"
+	// AttrRelFilePrefix the `relfileprefix` attribute, the prefix of the path of the other documents in cross references
+	AttrRelFilePrefix = "relfileprefix"
 	// AttrIDSeparator the key to retrieve the ID Separator
 	AttrIDSeparator = "idseparator"
 	// DefaultIDSeparator the default ID Separator
@@ -36,6 +40,12 @@ const (
 	AttrNumbered = "numbered"
 	// AttrSectionNumbers the `sectnums` attribute to trigger section numbering at renderding time (an alias for `numbered`)
 	AttrSectionNumbering = "sectnums"
+	// AttrPartNumbering the `partnums` attribute to trigger part numbering at rendering time (book doctype only)
+	AttrPartNumbering = "partnums"
+	// AttrPartSignifier the label prepended to the number of parts (eg: `Part`)
+	AttrPartSignifier = "part-signifier"
+	// AttrChapterSignifier the label prepended to the number of chapters (eg: `Chapter`)
+	AttrChapterSignifier = "chapter-signifier"
 	// AttrTableOfContents the `toc` attribute at document level
 	AttrTableOfContents = "toc"
 	// AttrTableOfContentsLevels the document attribute which specifies the number of levels to display in the ToC
@@ -104,6 +114,44 @@ const (
 	AttrImageWindow = "window"
 	// AttrImageAlign is for image alignment
 	AttrImageAlign = "align"
+	// AttrDataURI the `data-uri` attribute, to embed the images in the document as `data:` URIs
+	AttrDataURI = "data-uri"
+	// AttrStylesheet the `stylesheet` attribute, the custom stylesheet of the document (the default stylesheet is used if empty, and none if unset)
+	AttrStylesheet = "stylesheet"
+	// AttrStylesDir the `stylesdir` attribute, the directory of the stylesheet
+	AttrStylesDir = "stylesdir"
+	// AttrLinkCSS the `linkcss` attribute, to link the stylesheet to the document instead of embedding it
+	AttrLinkCSS = "linkcss"
+	// AttrCopyCSS the `copycss` attribute, to copy the linked stylesheet in the output directory
+	AttrCopyCSS = "copycss"
+	// AttrImageFormat the `format` attribute of images (eg: `svg` when the file has no `.svg` extension)
+	AttrImageFormat = "format"
+	// AttrDiagramTarget the `target` attribute of diagrams, ie, the name of the generated image (without extension)
+	AttrDiagramTarget = "target"
+	// AttrImagesOutDir the `imagesoutdir` attribute, the directory in which the images of the diagrams are generated
+	AttrImagesOutDir = "imagesoutdir"
+	// AttrImageFallback the `fallback` attribute of interactive SVG images (ie, the image to display if the SVG cannot be)
+	AttrImageFallback = "fallback"
+	// AttrImageInline the `inline` option of SVG images, to embed the SVG content in the document
+	AttrImageInline = "inline"
+	// AttrImageInteractive the `interactive` option of SVG images, to render them in an `<object>` element
+	AttrImageInteractive = "interactive"
+	// AttrMediaPoster the `poster` attribute of videos (an image, or the `youtube` or `vimeo` service hosting the video)
+	AttrMediaPoster = "poster"
+	// AttrMediaStart the `start` attribute of videos and audios (in seconds)
+	AttrMediaStart = "start"
+	// AttrMediaEnd the `end` attribute of videos and audios (in seconds)
+	AttrMediaEnd = "end"
+	// AttrMediaAutoplay the `autoplay` option of videos and audios
+	AttrMediaAutoplay = "autoplay"
+	// AttrMediaLoop the `loop` option of videos and audios
+	AttrMediaLoop = "loop"
+	// AttrMediaNoControls the `nocontrols` option of videos and audios
+	AttrMediaNoControls = "nocontrols"
+	// AttrMediaMuted the `muted` option of videos
+	AttrMediaMuted = "muted"
+	// AttrMediaNoFullScreen the `nofullscreen` option of videos hosted on YouTube or Vimeo
+	AttrMediaNoFullScreen = "nofullscreen"
 	// AttrIconSize the icon `size`, and can be one of 1x, 2x, 3x, 4x, 5x, lg, fw
 	AttrIconSize = "size"
 	// AttrIconRotate the icon `rotate` attribute, and can be one of 90, 180, or 270
@@ -134,6 +182,20 @@ const (
 	AttrCols = "cols"
 	// AttrAutoWidth the `autowidth` attribute on a table
 	AttrAutoWidth = "autowidth"
+	// AttrTableFormat the `format` attribute of a table (`psv`, `csv`, `tsv` or `dsv`)
+	AttrTableFormat = "format"
+	// AttrTableSeparator the `separator` attribute of a table, to override the default cell separator
+	AttrTableSeparator = "separator"
+	// AttrColSpan the number of columns spanned by a table cell (eg: `2+|`)
+	AttrColSpan = "colspan"
+	// AttrRowSpan the number of rows spanned by a table cell (eg: `.2+|`)
+	AttrRowSpan = "rowspan"
+	// AttrDuplicate the number of times a table cell is duplicated (eg: `3*|`)
+	AttrDuplicate = "duplicate"
+	// AttrHAlign the horizontal alignment of a table cell (eg: `^|`)
+	AttrHAlign = "halign"
+	// AttrVAlign the vertical alignment of a table cell (eg: `.^|`)
+	AttrVAlign = "valign"
 	// AttrPositionalIndex positional parameter index
 	AttrPositionalIndex = "@positional-"
 	// AttrPositional1 positional parameter 1
@@ -160,6 +222,8 @@ const (
 	AttrTipCaption = "tip-caption"
 	// AttrWarningCaption is the TIP caption
 	AttrWarningCaption = "warning-caption"
+	// AttrAppendixCaption is the appendix caption
+	AttrAppendixCaption = "appendix-caption"
 	// AttrSubstitutions the "subs" attribute to configure substitutions on delimited blocks and paragraphs
 	AttrSubstitutions = "subs"
 	// AttrImagesDir the `imagesdir` attribute
@@ -172,6 +236,30 @@ const (
 	AttrButtonLabel = "label"
 	// AttrHardBreaks the attribute to set on a paragraph to render with hard breaks on each line
 	AttrHardBreaks = "hardbreaks"
+	// AttrSafeModeName the `safe-mode-name` attribute, the name of the safe mode (eg: `secure`)
+	AttrSafeModeName = "safe-mode-name"
+	// AttrSafeModeLevel the `safe-mode-level` attribute, the level of the safe mode (eg: `20`)
+	AttrSafeModeLevel = "safe-mode-level"
+	// AttrBackEnd the name of the backend used to render the document (eg: `html5`)
+	AttrBackEnd = "backend"
+	// AttrBaseBackEnd the family of the backend used to render the document (`html`, `docbook` or `manpage`)
+	AttrBaseBackEnd = "basebackend"
+	// AttrManTitle the title of the manpage (eg: `git` in `= git(1)`)
+	AttrManTitle = "mantitle"
+	// AttrManVolNum the volume number of the manpage (eg: `1` in `= git(1)`)
+	AttrManVolNum = "manvolnum"
+	// AttrManManual the name of the manual the manpage belongs to
+	AttrManManual = "manmanual"
+	// AttrManSource the source (eg: name and version of the software) the manpage belongs to
+	AttrManSource = "mansource"
+	// AttrStem the `stem` attribute which enables the STEM macros and blocks, and defines the default interpreter (`asciimath` or `latexmath`)
+	AttrStem = "stem"
+	// AttrBibTeXFile the path to the BibTeX file which contains the entries cited with the `cite:[]` and `citenp:[]` macros
+	AttrBibTeXFile = "bibtex-file"
+	// AttrBibTeXStyle the style of the citations and bibliography (`numeric` or `author-year`)
+	AttrBibTeXStyle = "bibtex-style"
+	// AttrBibTeXOrder the order of the entries in the bibliography (`appearance` or `alphabetical`)
+	AttrBibTeXOrder = "bibtex-order"
 )
 
 // Attribute is a key/value pair wrapper
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"fmt"
+	"sync"
+)
+
+// DiagnosticSeverity the severity of a diagnostic
+type DiagnosticSeverity string
+
+const (
+	// SeverityWarning the severity of a problem which does not prevent the document from being rendered as expected
+	SeverityWarning DiagnosticSeverity = "warning"
+	// SeverityError the severity of a problem which prevents the document from being rendered as expected
+	SeverityError DiagnosticSeverity = "error"
+)
+
+// Level returns the level of the severity, so that severities can be compared (the higher, the more severe)
+func (s DiagnosticSeverity) Level() int {
+	switch s {
+	case SeverityWarning:
+		return 1
+	case SeverityError:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// DiagnosticCode the code which identifies the kind of problem reported in a diagnostic
+type DiagnosticCode string
+
+const (
+	// InvalidDocumentStructure the structure of the document does not match its doctype (eg: a manpage without a 'Name' section)
+	InvalidDocumentStructure DiagnosticCode = "invalid-document-structure"
+	// UnresolvedInclude the file to include could not be found or read, or the lines/tags to include are invalid
+	UnresolvedInclude DiagnosticCode = "unresolved-include"
+	// UnclosedIncludeTag a tag to include has no matching `end::tag[]` in the included file
+	UnclosedIncludeTag DiagnosticCode = "unclosed-include-tag"
+	// MissingAttribute an attribute reference to an undefined attribute
+	MissingAttribute DiagnosticCode = "missing-attribute"
+	// UnresolvedFootnoteReference a footnote reference to an undefined footnote
+	UnresolvedFootnoteReference DiagnosticCode = "unresolved-footnote-ref"
+	// UnresolvedCrossReference an internal cross reference to an undefined ID
+	UnresolvedCrossReference DiagnosticCode = "unresolved-xref"
+	// MissingImage an image to embed could not be found or read
+	MissingImage DiagnosticCode = "missing-image"
+	// MissingStylesheet a stylesheet (or one of its fonts or images) to embed could not be found or read
+	MissingStylesheet DiagnosticCode = "missing-stylesheet"
+	// UnknownIcon an icon which is not in the bundled set of SVG icons
+	UnknownIcon DiagnosticCode = "unknown-icon"
+	// UnresolvedBibliography the BibTeX file of the document could not be found or read
+	UnresolvedBibliography DiagnosticCode = "unresolved-bibliography"
+	// UnresolvedCitation a citation of an entry which is not in the BibTeX file of the document
+	UnresolvedCitation DiagnosticCode = "unresolved-citation"
+	// RestrictedAccess a file which cannot be read (or included) in the safe mode of the configuration
+	RestrictedAccess DiagnosticCode = "restricted-access"
+	// FailedDiagram a diagram whose image could not be generated (eg: the command of its converter is not installed)
+	FailedDiagram DiagnosticCode = "failed-diagram"
+	// InvalidTableSeparator the cell separator of a table cannot be used with its data format (eg: a newline, or a multi-character separator in CSV)
+	InvalidTableSeparator DiagnosticCode = "invalid-table-separator"
+)
+
+// Diagnostic a problem detected while processing a document
+type Diagnostic struct {
+	Severity DiagnosticSeverity
+	Code     DiagnosticCode
+	Message  string
+	Position *SourcePosition // may be nil if the position is unknown
+}
+
+func (d Diagnostic) String() string {
+	if d.Position != nil {
+		return fmt.Sprintf("%s: %s: %s (%s)", d.Position, d.Severity, d.Message, d.Code)
+	}
+	return fmt.Sprintf("%s: %s (%s)", d.Severity, d.Message, d.Code)
+}
+
+// Diagnostics collects the diagnostics reported while processing a document.
+// A nil `*Diagnostics` silently ignores the reported diagnostics.
+// Diagnostics can be reported concurrently (the parsing stages run in separate goroutines)
+type Diagnostics struct {
+	lock        sync.Mutex
+	diagnostics []Diagnostic
+}
+
+// NewDiagnostics returns a new, empty Diagnostics
+func NewDiagnostics() *Diagnostics {
+	return &Diagnostics{
+		diagnostics: []Diagnostic{},
+	}
+}
+
+// Warnf reports a diagnostic with the `warning` severity
+func (d *Diagnostics) Warnf(code DiagnosticCode, position *SourcePosition, format string, args ...interface{}) {
+	d.Report(Diagnostic{
+		Severity: SeverityWarning,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+		Position: position,
+	})
+}
+
+// Errorf reports a diagnostic with the `error` severity
+func (d *Diagnostics) Errorf(code DiagnosticCode, position *SourcePosition, format string, args ...interface{}) {
+	d.Report(Diagnostic{
+		Severity: SeverityError,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+		Position: position,
+	})
+}
+
+// Report reports the given diagnostic.
+// Note: the position is not copied, since it may be resolved (ie, the file and line are set)
+// after the diagnostic was reported, when the whole document has been parsed.
+func (d *Diagnostics) Report(diagnostic Diagnostic) {
+	if d == nil {
+		return
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.diagnostics = append(d.diagnostics, diagnostic)
+}
+
+// All returns all the diagnostics reported so far, in the order they were reported
+func (d *Diagnostics) All() []Diagnostic {
+	if d == nil {
+		return nil
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	result := make([]Diagnostic, len(d.diagnostics))
+	copy(result, d.diagnostics)
+	return result
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"path/filepath"
+	"sync"
+)
+
+// Catalog the titles and element references of a set of documents which are converted together (eg: the pages of a site),
+// so that the cross references between these documents can be resolved and checked
+type Catalog struct {
+	lock      sync.RWMutex
+	documents map[string]*CatalogEntry // entries indexed by the absolute path of the source document
+}
+
+// CatalogEntry the title, element references and output file of a document in a Catalog
+type CatalogEntry struct {
+	Title             []interface{} // the title of the document (may be nil)
+	ElementReferences ElementReferences
+	OutputPath        string // the path to the output file of the document (may be empty if unknown)
+}
+
+// NewCatalog returns a new, empty Catalog
+func NewCatalog() *Catalog {
+	return &Catalog{
+		documents: map[string]*CatalogEntry{},
+	}
+}
+
+// Add adds the title and element references of the given document, located at the given path
+func (c *Catalog) Add(path string, doc *Document) {
+	entry := &CatalogEntry{
+		ElementReferences: doc.ElementReferences,
+	}
+	if header, _ := doc.Header(); header != nil {
+		entry.Title = header.Title
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.documents[catalogKey(path)] = entry
+}
+
+// SetOutputPath sets the path to the output file of the document at the given path (if it is in this catalog),
+// so that the cross references to this document link to its actual output file
+func (c *Catalog) SetOutputPath(path, outputPath string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if entry, found := c.documents[catalogKey(path)]; found {
+		entry.OutputPath = outputPath
+	}
+}
+
+// Lookup returns the entry of the document at the given path, or `false` if there is no such document in this catalog
+func (c *Catalog) Lookup(path string) (*CatalogEntry, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	entry, found := c.documents[catalogKey(path)]
+	return entry, found
+}
+
+func catalogKey(path string) string {
+	if p, err := filepath.Abs(path); err == nil {
+		return p
+	}
+	return filepath.Clean(path)
+}
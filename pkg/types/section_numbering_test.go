@@ -42,7 +42,7 @@ var _ = Describe("section numbering", func() {
 			},
 		}
 		// when
-		n, err := doc.SectionNumbers()
+		n, err := doc.SectionNumbers(nil)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -80,7 +80,7 @@ var _ = Describe("section numbering", func() {
 			},
 		}
 		// when
-		n, err := doc.SectionNumbers()
+		n, err := doc.SectionNumbers(nil)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -118,7 +118,7 @@ var _ = Describe("section numbering", func() {
 			},
 		}
 		// when
-		n, err := doc.SectionNumbers()
+		n, err := doc.SectionNumbers(nil)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -151,7 +151,7 @@ var _ = Describe("section numbering", func() {
 			},
 		}
 		// when
-		n, err := doc.SectionNumbers()
+		n, err := doc.SectionNumbers(nil)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -195,7 +195,7 @@ var _ = Describe("section numbering", func() {
 			},
 		}
 		// when
-		n, err := doc.SectionNumbers()
+		n, err := doc.SectionNumbers(nil)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -250,7 +250,7 @@ var _ = Describe("section numbering", func() {
 			},
 		}
 		// when
-		n, err := doc.SectionNumbers()
+		n, err := doc.SectionNumbers(nil)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -259,4 +259,151 @@ var _ = Describe("section numbering", func() {
 		Expect(n["_introduction"]).To(Equal("1.1"))
 		Expect(n["_download_and_install"]).To(Equal("1.2"))
 	})
+
+	Context("in books", func() {
+
+		// part one > chapter A > section A.1, part two > chapter B, appendix > section, preface > section
+		newBook := func() *types.Document {
+			return &types.Document{
+				Elements: []interface{}{
+					&types.Section{
+						Level: 1,
+						Attributes: types.Attributes{
+							types.AttrID:          "_preface",
+							types.AttrPositional1: types.PrefaceSection,
+						},
+						Elements: []interface{}{
+							&types.Section{
+								Level: 2,
+								Attributes: types.Attributes{
+									types.AttrID: "_audience",
+								},
+							},
+						},
+					},
+					&types.Section{
+						Level: 0,
+						Attributes: types.Attributes{
+							types.AttrID: "_part_one",
+						},
+						Elements: []interface{}{
+							&types.Section{
+								Level: 1,
+								Attributes: types.Attributes{
+									types.AttrID: "_chapter_a",
+								},
+								Elements: []interface{}{
+									&types.Section{
+										Level: 2,
+										Attributes: types.Attributes{
+											types.AttrID: "_section_a_1",
+										},
+									},
+								},
+							},
+						},
+					},
+					&types.Section{
+						Level: 0,
+						Attributes: types.Attributes{
+							types.AttrID: "_part_two",
+						},
+						Elements: []interface{}{
+							&types.Section{
+								Level: 1,
+								Attributes: types.Attributes{
+									types.AttrID: "_chapter_b",
+								},
+							},
+							&types.Section{
+								Level: 1,
+								Attributes: types.Attributes{
+									types.AttrID:    "_tools",
+									types.AttrStyle: types.AppendixSection,
+								},
+								Elements: []interface{}{
+									&types.Section{
+										Level: 2,
+										Attributes: types.Attributes{
+											types.AttrID: "_hammer",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		It("should number chapters across parts", func() {
+			// given
+			doc := newBook()
+			// when
+			n, err := doc.SectionNumbers(types.Attributes{
+				types.AttrDocType:          "book",
+				types.AttrSectionNumbering: nil,
+			})
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(Equal(types.SectionNumbers{
+				"_chapter_a":   "1",
+				"_section_a_1": "1.1",
+				"_chapter_b":   "2",
+				"_tools":       "A",
+				"_hammer":      "A.1",
+			}))
+		})
+
+		It("should number parts", func() {
+			// given
+			doc := newBook()
+			// when
+			n, err := doc.SectionNumbers(types.Attributes{
+				types.AttrDocType:       "book",
+				types.AttrPartNumbering: nil,
+			})
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(Equal(types.SectionNumbers{
+				"_part_one": "I",
+				"_part_two": "II",
+				"_tools":    "A",
+			}))
+		})
+
+		It("should number parts and chapters with attributes in header", func() {
+			// given
+			doc := newBook()
+			doc.Elements = append([]interface{}{
+				&types.DocumentHeader{
+					Elements: []interface{}{
+						&types.AttributeDeclaration{
+							Name:  types.AttrDocType,
+							Value: "book",
+						},
+						&types.AttributeDeclaration{
+							Name: types.AttrPartNumbering,
+						},
+						&types.AttributeDeclaration{
+							Name: types.AttrSectionNumbering,
+						},
+					},
+				},
+			}, doc.Elements...)
+			// when
+			n, err := doc.SectionNumbers(nil)
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(Equal(types.SectionNumbers{
+				"_part_one":    "I",
+				"_chapter_a":   "1",
+				"_section_a_1": "1.1",
+				"_part_two":    "II",
+				"_chapter_b":   "2",
+				"_tools":       "A",
+				"_hammer":      "A.1",
+			}))
+		})
+	})
 })
@@ -1,16 +1,19 @@
 package types
 
 import (
+	"encoding/csv"
 	"fmt"
-	"math"
 	"net/url"
 	"sort"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/text/unicode/norm"
 	"gopkg.in/yaml.v2"
 )
 
@@ -50,6 +53,11 @@ type WithLocation interface {
 	GetLocation() *Location
 }
 
+type WithSourcePosition interface {
+	GetSourcePosition() *SourcePosition
+	SetSourcePosition(*SourcePosition)
+}
+
 type Referencable interface {
 	Reference(refs ElementReferences)
 }
@@ -70,6 +78,34 @@ type Position struct {
 	End   int
 }
 
+// SourcePosition the position of an element in the source, i.e., the file, line and column (starting at 1)
+// where the element begins, once the file inclusions have been resolved
+type SourcePosition struct {
+	File   string
+	Line   int
+	Column int
+}
+
+func (p *SourcePosition) String() string {
+	if p == nil {
+		return ""
+	}
+	return p.File + ":" + strconv.Itoa(p.Line) + ":" + strconv.Itoa(p.Column)
+}
+
+// SourceMap the origin of each line of a preprocessed document, which may include
+// the content of other files (the origin of the `n`th line is at index `n-1`)
+type SourceMap []SourcePosition
+
+// Resolve returns the file and line of the given line of the preprocessed document,
+// or the given filename and line if the line is not covered by the source map
+func (m SourceMap) Resolve(filename string, line int) (string, int) {
+	if line < 1 || line > len(m) {
+		return filename, line
+	}
+	return m[line-1].File, m[line-1].Line
+}
+
 func NewDocumentFragment(p Position, elements ...interface{}) DocumentFragment {
 	return DocumentFragment{
 		Position: p,
@@ -94,6 +130,7 @@ type Document struct {
 	ElementReferences ElementReferences
 	Footnotes         []*Footnote
 	TableOfContents   *TableOfContents
+	Index             *Index
 }
 
 // FrontMatter returns the FrontMatter element if it is in the first position
@@ -150,51 +187,112 @@ func (d *Document) AddElement(element interface{}) error {
 
 type SectionNumbers map[string]string // assigned number by section id
 
-func (d *Document) SectionNumbers() (SectionNumbers, error) {
-	enabled := false
+// SectionNumbers returns the numbers assigned to the sections of this document,
+// given the attributes (eg: `sectnums`, `doctype`, etc.) which apply before the first section.
+// In books, parts are numbered with roman numerals when `partnums` is set, chapters are numbered
+// across parts and appendices are lettered. Special sections (preface, glossary, etc.) are not numbered.
+func (d *Document) SectionNumbers(attrs Attributes) (SectionNumbers, error) {
+	n := &sectionNumbering{
+		numbers:  SectionNumbers{},
+		enabled:  attrs.Has(AttrSectionNumbering) || attrs.Has(AttrNumbered),
+		partnums: attrs.Has(AttrPartNumbering),
+		book:     attrs.GetAsStringWithDefault(AttrDocType, "") == "book",
+	}
 	if header, _ := d.Header(); header != nil {
-		// lookup the `sectnums` or `numbered` attribute in the header
-		var err error
-		if _, enabled, err = traverseElements(header.Elements, false, ""); err != nil {
-			return nil, err
-		}
+		// lookup the `sectnums`, `numbered`, `partnums` or `doctype` attributes in the header
+		n.traverse(header.Elements, "")
 	}
-	numbers, _, err := traverseElements(d.Elements, enabled, "") // disabled by default
-	return numbers, err
+	n.traverse(d.Elements, "") // disabled by default
+	return n.numbers, nil
 }
 
-func traverseElements(elements []interface{}, enabled bool, prefix string) (map[string]string, bool, error) {
-	result := map[string]string{}
+type sectionNumbering struct {
+	numbers    SectionNumbers
+	enabled    bool
+	partnums   bool
+	book       bool
+	parts      int
+	chapters   int // top-level sections, whose numbering continues across parts
+	appendices int
+}
+
+func (n *sectionNumbering) traverse(elements []interface{}, prefix string) {
 	counter := 0
 	for _, e := range elements {
 		switch e := e.(type) {
 		case *AttributeDeclaration:
-			if e.Name == AttrSectionNumbering || e.Name == AttrNumbered {
-				enabled = true
-			}
+			n.toggle(e.Name, e.Value, true)
 		case *AttributeReset:
-			if e.Name == AttrSectionNumbering || e.Name == AttrNumbered {
-				enabled = false
-			}
+			n.toggle(e.Name, nil, false)
 		case *Section:
-			var n string
-			if enabled {
-				id := e.GetID()
-				counter++
-				n = prefix + strconv.Itoa(counter)
-				result[id] = n
-			}
-			numbers, en, err := traverseElements(e.Elements, enabled, n+".")
-			if err != nil {
-				return nil, false, err
-			}
-			enabled = en
-			for id, n := range numbers {
-				result[id] = n
+			switch {
+			case n.book && e.Level == 0 && e.GetStyle() == "":
+				// parts are only numbered when `partnums` is set
+				if n.partnums {
+					n.parts++
+					n.numbers[e.GetID()] = toRoman(n.parts)
+				}
+				n.traverse(e.Elements, "")
+			case e.GetStyle() == AppendixSection:
+				// appendices are always lettered, but their subsections are only numbered when `sectnums` is set
+				n.appendices++
+				letter := string(rune('A' + (n.appendices-1)%26))
+				n.numbers[e.GetID()] = letter
+				n.traverse(e.Elements, letter+".")
+			case e.IsSpecial():
+				// special sections and their subsections are not numbered
+				enabled := n.enabled
+				n.enabled = false
+				n.traverse(e.Elements, ".")
+				n.enabled = enabled
+			default:
+				var number string
+				if n.enabled {
+					if prefix == "" {
+						n.chapters++
+						number = strconv.Itoa(n.chapters)
+					} else {
+						counter++
+						number = prefix + strconv.Itoa(counter)
+					}
+					n.numbers[e.GetID()] = number
+				}
+				n.traverse(e.Elements, number+".")
 			}
 		}
 	}
-	return result, enabled, nil
+}
+
+func (n *sectionNumbering) toggle(name string, value interface{}, set bool) {
+	switch name {
+	case AttrSectionNumbering, AttrNumbered:
+		n.enabled = set
+	case AttrPartNumbering:
+		n.partnums = set
+	case AttrDocType:
+		n.book = set && value == "book"
+	}
+}
+
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// toRoman returns the given (positive) number in upper roman numerals
+func toRoman(number int) string {
+	result := &strings.Builder{}
+	for _, n := range romanNumerals {
+		for number >= n.value {
+			result.WriteString(n.symbol)
+			number -= n.value
+		}
+	}
+	return result.String()
 }
 
 // ------------------------------------------
@@ -208,6 +306,8 @@ type Metadata struct {
 	TableOfContents *TableOfContents
 	Authors         []*DocumentAuthor
 	Revision        DocumentRevision
+	ManTitle        string // the `mantitle` attribute of a manpage (eg: `git` in `= git(1)`)
+	ManVolNum       string // the `manvolnum` attribute of a manpage (eg: `1` in `= git(1)`)
 }
 
 func NewTableOfContents(maxDepth int) *TableOfContents {
@@ -243,26 +343,21 @@ func (t *TableOfContents) Add(s *Section) {
 		ID:    s.GetAttributes().GetAsStringWithDefault(AttrID, ""),
 		Level: s.Level,
 	}
-	// lookup the last child at the given section's level
-	if len(t.Sections) == 0 {
-		t.Sections = []*ToCSection{ts}
-		return
-	} else if s.Level == t.Sections[0].Level {
-		// add at top level
-		t.Sections = append(t.Sections, ts)
-		return
-	}
-	// look-up parent for the ts, starting with the last section at root level
-	parent := t.Sections[len(t.Sections)-1]
-	for {
-		if len(parent.Children) == 0 ||
-			parent.Children[0].Level == s.Level {
-			// (first) child level matches section level
-			// or no child beneath current parent
+	// look-up the parent for the ts, ie, the last (deepest) section with a lower level,
+	// starting with the last section at root level
+	var parent *ToCSection
+	for candidates := t.Sections; len(candidates) > 0; {
+		last := candidates[len(candidates)-1]
+		if last.Level >= s.Level {
 			break
 		}
-		// move to last child of current parent
-		parent = parent.Children[len(parent.Children)-1]
+		parent = last
+		candidates = last.Children
+	}
+	if parent == nil {
+		// add at top level (including a book part which follows a preface)
+		t.Sections = append(t.Sections, ts)
+		return
 	}
 	parent.Children = append(parent.Children, ts)
 }
@@ -281,9 +376,20 @@ type DocumentElement interface {
 // ------------------------------------------
 
 type DocumentHeader struct {
-	Title      []interface{}
-	Attributes Attributes
-	Elements   []interface{}
+	Title          []interface{}
+	Attributes     Attributes
+	Elements       []interface{}
+	SourcePosition *SourcePosition
+}
+
+// GetSourcePosition returns the position of this DocumentHeader in the source
+func (h *DocumentHeader) GetSourcePosition() *SourcePosition {
+	return h.SourcePosition
+}
+
+// SetSourcePosition sets the position of this DocumentHeader in the source
+func (h *DocumentHeader) SetSourcePosition(position *SourcePosition) {
+	h.SourcePosition = position
 }
 
 func NewDocumentHeader(title []interface{}, ar interface{}, extraAttrs []interface{}) (*DocumentHeader, error) {
@@ -790,9 +896,20 @@ func addToListElement(e ListElement, element interface{}) error {
 }
 
 type List struct {
-	Kind       ListKind
-	Attributes Attributes
-	Elements   []ListElement
+	Kind           ListKind
+	Attributes     Attributes
+	Elements       []ListElement
+	SourcePosition *SourcePosition
+}
+
+// GetSourcePosition returns the position of this List in the source
+func (l *List) GetSourcePosition() *SourcePosition {
+	return l.SourcePosition
+}
+
+// SetSourcePosition sets the position of this List in the source
+func (l *List) SetSourcePosition(position *SourcePosition) {
+	l.SourcePosition = position
 }
 
 var _ WithElements = &List{}
@@ -1003,6 +1120,11 @@ func NewList(element ListElement) (*List, error) {
 			element,
 		},
 	}
+	// the list starts where its first element starts
+	if e, ok := element.(WithSourcePosition); ok && e.GetSourcePosition() != nil {
+		p := *e.GetSourcePosition()
+		list.SourcePosition = &p
+	}
 	// interactive unordered list elements (applies to all elements of the list)
 	// TODO: move into `types.GenericList.AddElement()`` ?
 	if u, ok := element.(*UnorderedListElement); ok && list.Attributes.HasOption(AttrInteractive) {
@@ -1051,9 +1173,20 @@ func NewCallout(ref int) (*Callout, error) {
 
 // CalloutListElement the description of a call out which will appear as an ordered list item after the delimited block
 type CalloutListElement struct {
-	Attributes Attributes
-	Ref        int
-	Elements   []interface{}
+	Attributes     Attributes
+	Ref            int
+	Elements       []interface{}
+	SourcePosition *SourcePosition
+}
+
+// GetSourcePosition returns the position of this CalloutListElement in the source
+func (e *CalloutListElement) GetSourcePosition() *SourcePosition {
+	return e.SourcePosition
+}
+
+// SetSourcePosition sets the position of this CalloutListElement in the source
+func (e *CalloutListElement) SetSourcePosition(position *SourcePosition) {
+	e.SourcePosition = position
 }
 
 var _ ListElement = &CalloutListElement{}
@@ -1173,9 +1306,20 @@ const (
 
 // OrderedListElement the structure for the ordered list items
 type OrderedListElement struct {
-	Attributes Attributes
-	Style      string // TODO: rename to `OrderedListElementNumberingStyle`? TODO: define as an attribute instead?
-	Elements   []interface{}
+	Attributes     Attributes
+	Style          string // TODO: rename to `OrderedListElementNumberingStyle`? TODO: define as an attribute instead?
+	Elements       []interface{}
+	SourcePosition *SourcePosition
+}
+
+// GetSourcePosition returns the position of this OrderedListElement in the source
+func (e *OrderedListElement) GetSourcePosition() *SourcePosition {
+	return e.SourcePosition
+}
+
+// SetSourcePosition sets the position of this OrderedListElement in the source
+func (e *OrderedListElement) SetSourcePosition(position *SourcePosition) {
+	e.SourcePosition = position
 }
 
 // making sure that the `ListElement` interface is implemented by `OrderedListElement`
@@ -1305,10 +1449,21 @@ func NewOrderedListElementPrefix(s string) (OrderedListElementPrefix, error) {
 
 // UnorderedListElement the structure for the unordered list items
 type UnorderedListElement struct {
-	BulletStyle UnorderedListElementBulletStyle
-	CheckStyle  UnorderedListElementCheckStyle
-	Attributes  Attributes
-	Elements    []interface{}
+	BulletStyle    UnorderedListElementBulletStyle
+	CheckStyle     UnorderedListElementCheckStyle
+	Attributes     Attributes
+	Elements       []interface{}
+	SourcePosition *SourcePosition
+}
+
+// GetSourcePosition returns the position of this UnorderedListElement in the source
+func (e *UnorderedListElement) GetSourcePosition() *SourcePosition {
+	return e.SourcePosition
+}
+
+// SetSourcePosition sets the position of this UnorderedListElement in the source
+func (e *UnorderedListElement) SetSourcePosition(position *SourcePosition) {
+	e.SourcePosition = position
 }
 
 var _ ListElement = &UnorderedListElement{}
@@ -1582,10 +1737,21 @@ func toLabeledListElementStyle(level int) (LabeledListElementStyle, error) {
 
 // LabeledListElement an item in a labeled
 type LabeledListElement struct {
-	Term       []interface{}
-	Attributes Attributes
-	Style      LabeledListElementStyle
-	Elements   []interface{}
+	Term           []interface{}
+	Attributes     Attributes
+	Style          LabeledListElementStyle
+	Elements       []interface{}
+	SourcePosition *SourcePosition
+}
+
+// GetSourcePosition returns the position of this LabeledListElement in the source
+func (e *LabeledListElement) GetSourcePosition() *SourcePosition {
+	return e.SourcePosition
+}
+
+// SetSourcePosition sets the position of this LabeledListElement in the source
+func (e *LabeledListElement) SetSourcePosition(position *SourcePosition) {
+	e.SourcePosition = position
 }
 
 // NewLabeledListElement initializes a new LabeledListElement
@@ -1728,8 +1894,19 @@ func (e *LabeledListElement) SubstituteFootnotes(notes *Footnotes) {
 
 // Paragraph the structure for the paragraphs
 type Paragraph struct {
-	Attributes Attributes
-	Elements   []interface{}
+	Attributes     Attributes
+	Elements       []interface{}
+	SourcePosition *SourcePosition
+}
+
+// GetSourcePosition returns the position of this Paragraph in the source
+func (p *Paragraph) GetSourcePosition() *SourcePosition {
+	return p.SourcePosition
+}
+
+// SetSourcePosition sets the position of this Paragraph in the source
+func (p *Paragraph) SetSourcePosition(position *SourcePosition) {
+	p.SourcePosition = position
 }
 
 // NewParagraph initializes a new `Paragraph`
@@ -1819,6 +1996,12 @@ func (p *Paragraph) Reference(refs ElementReferences) {
 	if id != "" && title != nil {
 		refs[id] = title
 	}
+	// also, register the bibliography anchors (eg: `[[[pp]]]`)
+	for _, e := range p.Elements {
+		if e, ok := e.(*BibliographyAnchor); ok {
+			e.Reference(refs)
+		}
+	}
 }
 
 var _ WithFootnotes = &Paragraph{}
@@ -2002,8 +2185,19 @@ func NewInlineMenu(id string, attrs Attributes) (*InlineMenu, error) {
 
 // ImageBlock the structure for the block images
 type ImageBlock struct {
-	Location   *Location
-	Attributes Attributes
+	Location       *Location
+	Attributes     Attributes
+	SourcePosition *SourcePosition
+}
+
+// GetSourcePosition returns the position of this ImageBlock in the source
+func (i *ImageBlock) GetSourcePosition() *SourcePosition {
+	return i.SourcePosition
+}
+
+// SetSourcePosition sets the position of this ImageBlock in the source
+func (i *ImageBlock) SetSourcePosition(position *SourcePosition) {
+	i.SourcePosition = position
 }
 
 // NewImageBlock initializes a new `ImageBlock`
@@ -2097,6 +2291,240 @@ func (i *InlineImage) GetLocation() *Location {
 	return i.Location
 }
 
+// ------------------------------------------
+// Videos and Audios
+// ------------------------------------------
+
+const (
+	// YouTube the service hosting the video, when set as the `poster` attribute of the video
+	YouTube string = "youtube"
+	// Vimeo the service hosting the video, when set as the `poster` attribute of the video
+	Vimeo string = "vimeo"
+)
+
+// VideoBlock the structure for the video block macros (eg: `video::screencast.mp4[]` or `video::rPQoq7ThGAU[youtube]`)
+type VideoBlock struct {
+	Location       *Location
+	Attributes     Attributes
+	SourcePosition *SourcePosition
+}
+
+// NewVideoBlock initializes a new `VideoBlock`
+func NewVideoBlock(location *Location, inlineAttributes Attributes) (*VideoBlock, error) {
+	attrs := Attributes{}
+	attrs.SetAll(inlineAttributes)
+	attrs = toAttributesWithMapping(attrs, map[string]string{
+		AttrPositional1: AttrMediaPoster,
+		AttrPositional2: AttrWidth,
+		AttrPositional3: AttrHeight,
+	})
+	return &VideoBlock{
+		Location:   location,
+		Attributes: attrs,
+	}, nil
+}
+
+// GetSourcePosition returns the position of this VideoBlock in the source
+func (v *VideoBlock) GetSourcePosition() *SourcePosition {
+	return v.SourcePosition
+}
+
+// SetSourcePosition sets the position of this VideoBlock in the source
+func (v *VideoBlock) SetSourcePosition(position *SourcePosition) {
+	v.SourcePosition = position
+}
+
+var _ WithAttributes = &VideoBlock{}
+
+// GetAttributes returns this element's attributes
+func (v *VideoBlock) GetAttributes() Attributes {
+	return v.Attributes
+}
+
+// AddAttributes adds the attributes of this element
+func (v *VideoBlock) AddAttributes(attributes Attributes) {
+	v.Attributes = v.Attributes.AddAll(attributes)
+}
+
+// SetAttributes sets the attributes in this element
+func (v *VideoBlock) SetAttributes(attributes Attributes) {
+	v.Attributes = attributes
+}
+
+var _ WithLocation = &VideoBlock{}
+
+func (v *VideoBlock) GetLocation() *Location {
+	return v.Location
+}
+
+// Service returns the service hosting the video (`youtube` or `vimeo`), or an empty string if the video is a file
+func (v *VideoBlock) Service() string {
+	switch p := v.Attributes.GetAsStringWithDefault(AttrMediaPoster, ""); p {
+	case YouTube, Vimeo:
+		return p
+	default:
+		return ""
+	}
+}
+
+var _ Referencable = &VideoBlock{}
+
+func (v *VideoBlock) Reference(refs ElementReferences) {
+	id := v.Attributes.GetAsStringWithDefault(AttrID, "")
+	title := v.Attributes.GetAsStringWithDefault(AttrTitle, "")
+	if id != "" && title != "" {
+		refs[id] = title
+	}
+}
+
+// AudioBlock the structure for the audio block macros (eg: `audio::podcast.mp3[]`)
+type AudioBlock struct {
+	Location       *Location
+	Attributes     Attributes
+	SourcePosition *SourcePosition
+}
+
+// NewAudioBlock initializes a new `AudioBlock`
+func NewAudioBlock(location *Location, inlineAttributes Attributes) (*AudioBlock, error) {
+	attrs := Attributes{}
+	attrs.SetAll(inlineAttributes)
+	attrs = toAttributesWithMapping(attrs, nil) // no positional attribute
+	return &AudioBlock{
+		Location:   location,
+		Attributes: attrs,
+	}, nil
+}
+
+// GetSourcePosition returns the position of this AudioBlock in the source
+func (a *AudioBlock) GetSourcePosition() *SourcePosition {
+	return a.SourcePosition
+}
+
+// SetSourcePosition sets the position of this AudioBlock in the source
+func (a *AudioBlock) SetSourcePosition(position *SourcePosition) {
+	a.SourcePosition = position
+}
+
+var _ WithAttributes = &AudioBlock{}
+
+// GetAttributes returns this element's attributes
+func (a *AudioBlock) GetAttributes() Attributes {
+	return a.Attributes
+}
+
+// AddAttributes adds the attributes of this element
+func (a *AudioBlock) AddAttributes(attributes Attributes) {
+	a.Attributes = a.Attributes.AddAll(attributes)
+}
+
+// SetAttributes sets the attributes in this element
+func (a *AudioBlock) SetAttributes(attributes Attributes) {
+	a.Attributes = attributes
+}
+
+var _ WithLocation = &AudioBlock{}
+
+func (a *AudioBlock) GetLocation() *Location {
+	return a.Location
+}
+
+var _ Referencable = &AudioBlock{}
+
+func (a *AudioBlock) Reference(refs ElementReferences) {
+	id := a.Attributes.GetAsStringWithDefault(AttrID, "")
+	title := a.Attributes.GetAsStringWithDefault(AttrTitle, "")
+	if id != "" && title != "" {
+		refs[id] = title
+	}
+}
+
+// ------------------------------------------
+// Diagrams
+// ------------------------------------------
+
+// DiagramBlock a diagram (eg: a `[graphviz]` block or a `plantuml::sequence.puml[]` block macro),
+// whose source is converted into an image by the converter registered for its kind
+type DiagramBlock struct {
+	Kind           string    // the kind of diagram (eg: `graphviz`, `plantuml`)
+	Source         string    // the source of the diagram, when defined in a block
+	Location       *Location // the file containing the source of the diagram, when defined in a block macro
+	Attributes     Attributes
+	SourcePosition *SourcePosition
+}
+
+// NewDiagramBlock initializes a new `DiagramBlock` from a paragraph or a delimited block,
+// in which the first positional attribute is the kind of diagram
+func NewDiagramBlock(kind, source string, blockAttributes Attributes) *DiagramBlock {
+	attrs := Attributes{}
+	attrs.SetAll(blockAttributes)
+	attrs.Unset(AttrStyle)
+	attrs.Unset(AttrPositional1)
+	// the positional attributes of the listing blocks were already mapped to `language` and `linenums`
+	attrs = toAttributesWithMapping(attrs, map[string]string{
+		AttrPositional2: AttrDiagramTarget,
+		AttrLanguage:    AttrDiagramTarget,
+		AttrPositional3: AttrImageFormat,
+		AttrLineNums:    AttrImageFormat,
+	})
+	return &DiagramBlock{
+		Kind:       kind,
+		Source:     source,
+		Attributes: attrs,
+	}
+}
+
+// NewDiagramBlockFromMacro initializes a new `DiagramBlock` from a block macro (eg: `plantuml::sequence.puml[]`)
+func NewDiagramBlockFromMacro(kind string, location *Location, inlineAttributes Attributes) *DiagramBlock {
+	attrs := Attributes{}
+	attrs.SetAll(inlineAttributes)
+	attrs = toAttributesWithMapping(attrs, map[string]string{
+		AttrPositional1: AttrDiagramTarget,
+		AttrPositional2: AttrImageFormat,
+	})
+	return &DiagramBlock{
+		Kind:       kind,
+		Location:   location,
+		Attributes: attrs,
+	}
+}
+
+// GetSourcePosition returns the position of this DiagramBlock in the source
+func (d *DiagramBlock) GetSourcePosition() *SourcePosition {
+	return d.SourcePosition
+}
+
+// SetSourcePosition sets the position of this DiagramBlock in the source
+func (d *DiagramBlock) SetSourcePosition(position *SourcePosition) {
+	d.SourcePosition = position
+}
+
+var _ WithAttributes = &DiagramBlock{}
+
+// GetAttributes returns this element's attributes
+func (d *DiagramBlock) GetAttributes() Attributes {
+	return d.Attributes
+}
+
+// AddAttributes adds the attributes of this element
+func (d *DiagramBlock) AddAttributes(attributes Attributes) {
+	d.Attributes = d.Attributes.AddAll(attributes)
+}
+
+// SetAttributes sets the attributes in this element
+func (d *DiagramBlock) SetAttributes(attributes Attributes) {
+	d.Attributes = attributes
+}
+
+var _ Referencable = &DiagramBlock{}
+
+func (d *DiagramBlock) Reference(refs ElementReferences) {
+	id := d.Attributes.GetAsStringWithDefault(AttrID, "")
+	title := d.Attributes.GetAsStringWithDefault(AttrTitle, "")
+	if id != "" && title != "" {
+		refs[id] = title
+	}
+}
+
 // ------------------------------------------
 // Icons
 // ------------------------------------------
@@ -2169,8 +2597,16 @@ type WithFootnotes interface {
 // during the parsing phase and displayed at the bottom of the document
 // during the rendering.
 type Footnotes struct {
-	sequence *sequence
-	Notes    []*Footnote
+	sequence   *sequence
+	Notes      []*Footnote
+	Unresolved []UnresolvedFootnote
+}
+
+// UnresolvedFootnote a reference to a footnote which does not exist,
+// along with the position of the element in which the reference was found (if known)
+type UnresolvedFootnote struct {
+	Ref      string
+	Position *SourcePosition
 }
 
 // NewFootnotes initializes a new Footnotes
@@ -2212,6 +2648,9 @@ func (f *Footnotes) Reference(note *Footnote) *FootnoteReference {
 	} else {
 		r.ID = InvalidFootnoteReference
 		log.Warnf("no footnote with reference '%s'", note.Ref)
+		f.Unresolved = append(f.Unresolved, UnresolvedFootnote{
+			Ref: note.Ref,
+		})
 	}
 	r.Ref = note.Ref
 	return r
@@ -2260,6 +2699,12 @@ const (
 	Source string = "source"
 	// Passthrough a passthrough block
 	Passthrough string = "pass"
+	// Stem a STEM block or paragraph, using the interpreter defined by the `stem` document attribute
+	Stem string = "stem"
+	// LatexMath a STEM block or paragraph using the LaTeX math interpreter
+	LatexMath string = "latexmath"
+	// AsciiMath a STEM block or paragraph using the AsciiMath interpreter
+	AsciiMath string = "asciimath"
 )
 
 // LiteralParagraph custom type to retain the number of spaces on the first line (needed during rendering)
@@ -2297,9 +2742,20 @@ func (b *BlockDelimiter) RawText() string {
 
 // DelimitedBlock the structure for the Listing blocks
 type DelimitedBlock struct {
-	Kind       string
-	Attributes Attributes
-	Elements   []interface{}
+	Kind           string
+	Attributes     Attributes
+	Elements       []interface{}
+	SourcePosition *SourcePosition
+}
+
+// GetSourcePosition returns the position of this DelimitedBlock in the source
+func (b *DelimitedBlock) GetSourcePosition() *SourcePosition {
+	return b.SourcePosition
+}
+
+// SetSourcePosition sets the position of this DelimitedBlock in the source
+func (b *DelimitedBlock) SetSourcePosition(position *SourcePosition) {
+	b.SourcePosition = position
 }
 
 func NewDelimitedBlock(kind string, elements []interface{}) (*DelimitedBlock, error) {
@@ -2391,6 +2847,11 @@ func (b *DelimitedBlock) mapAttributes() {
 			AttrPositional2: AttrLanguage,
 			AttrPositional3: AttrLineNums,
 		})
+	case Passthrough:
+		// eg: `[stem]`
+		b.Attributes = toAttributesWithMapping(b.Attributes, map[string]string{
+			AttrPositional1: AttrStyle,
+		})
 	}
 }
 
@@ -2438,10 +2899,21 @@ func (s *RawSection) Stringify() string {
 
 // Section the structure for a section
 type Section struct {
-	Level      int
-	Attributes Attributes
-	Title      []interface{}
-	Elements   []interface{}
+	Level          int
+	Attributes     Attributes
+	Title          []interface{}
+	Elements       []interface{}
+	SourcePosition *SourcePosition
+}
+
+// GetSourcePosition returns the position of this Section in the source
+func (s *Section) GetSourcePosition() *SourcePosition {
+	return s.SourcePosition
+}
+
+// SetSourcePosition sets the position of this Section in the source
+func (s *Section) SetSourcePosition(position *SourcePosition) {
+	s.SourcePosition = position
 }
 
 // NewSection returns a new Section
@@ -2454,6 +2926,48 @@ func NewSection(level int, title []interface{}) (*Section, error) {
 	return s, nil
 }
 
+// section styles
+const (
+	// AbstractSection an abstract
+	AbstractSection string = "abstract"
+	// AcknowledgmentsSection the acknowledgments of a book
+	AcknowledgmentsSection string = "acknowledgments"
+	// AppendixSection an appendix
+	AppendixSection string = "appendix"
+	// BibliographySection a bibliography
+	BibliographySection string = "bibliography"
+	// ColophonSection the colophon of a book
+	ColophonSection string = "colophon"
+	// DedicationSection the dedication of a book
+	DedicationSection string = "dedication"
+	// GlossarySection a glossary
+	GlossarySection string = "glossary"
+	// IndexSection an index
+	IndexSection string = "index"
+	// PrefaceSection the preface of a book
+	PrefaceSection string = "preface"
+)
+
+// GetStyle returns the style of the section (eg: `appendix`, `preface`, etc.), or an empty string if none was set
+func (s *Section) GetStyle() string {
+	if style, found := s.Attributes.GetAsString(AttrStyle); found {
+		return style
+	}
+	style, _ := s.Attributes.GetAsString(AttrPositional1)
+	return style
+}
+
+// IsSpecial returns `true` if the section has a special style (eg: `appendix`, `preface`, etc.)
+func (s *Section) IsSpecial() bool {
+	switch s.GetStyle() {
+	case AbstractSection, AcknowledgmentsSection, AppendixSection, BibliographySection,
+		ColophonSection, DedicationSection, GlossarySection, IndexSection, PrefaceSection:
+		return true
+	default:
+		return false
+	}
+}
+
 func (s *Section) GetID() string {
 	id, _ := s.Attributes.GetAsString(AttrID)
 	return id
@@ -2605,7 +3119,19 @@ type TableOfContentsPlaceHolder struct {
 // ------------------------------------------
 
 // ThematicBreak a thematic break
-type ThematicBreak struct{}
+type ThematicBreak struct {
+	SourcePosition *SourcePosition
+}
+
+// GetSourcePosition returns the position of this ThematicBreak in the source
+func (b *ThematicBreak) GetSourcePosition() *SourcePosition {
+	return b.SourcePosition
+}
+
+// SetSourcePosition sets the position of this ThematicBreak in the source
+func (b *ThematicBreak) SetSourcePosition(position *SourcePosition) {
+	b.SourcePosition = position
+}
 
 // NewThematicBreak returns a new ThematicBreak
 func NewThematicBreak() (*ThematicBreak, error) {
@@ -2865,6 +3391,125 @@ func NewInlinePassthrough(kind PassthroughKind, elements []interface{}) (*Inline
 	}, nil
 }
 
+// ------------------------------------------
+// InlineStem
+// ------------------------------------------
+
+// InlineStem a STEM expression (ie, an equation) declared with the `stem:[]`, `latexmath:[]` or `asciimath:[]` inline macro
+type InlineStem struct {
+	Kind    string // `stem`, `latexmath` or `asciimath`
+	Content string
+}
+
+// NewInlineStem returns a new InlineStem
+func NewInlineStem(kind, content string) (*InlineStem, error) {
+	return &InlineStem{
+		Kind:    kind,
+		Content: strings.ReplaceAll(content, `\]`, "]"),
+	}, nil
+}
+
+// ------------------------------------------
+// Bibliography
+// ------------------------------------------
+
+// BibliographyAnchor the anchor of an entry in a bibliography list (eg: `[[[pp]]]` or `[[[pp,Pragmatic]]]`)
+type BibliographyAnchor struct {
+	ID    string
+	Label string // the text to display (and to use in cross references), or the ID if none was set
+}
+
+// NewBibliographyAnchor returns a new BibliographyAnchor
+func NewBibliographyAnchor(id string, label interface{}) (*BibliographyAnchor, error) {
+	a := &BibliographyAnchor{
+		ID:    id,
+		Label: id,
+	}
+	if label, ok := label.(string); ok && strings.TrimSpace(label) != "" {
+		a.Label = strings.TrimSpace(label)
+	}
+	return a, nil
+}
+
+var _ Referencable = &BibliographyAnchor{}
+
+// Reference registers the label of the entry, so that cross references display it between brackets
+func (a *BibliographyAnchor) Reference(refs ElementReferences) {
+	refs[a.ID] = "[" + a.Label + "]"
+}
+
+const (
+	// Citation a parenthetical citation (eg: `(Lane, 2000)`), with the `cite:[]` macro
+	Citation string = "cite"
+	// NonParentheticalCitation a non-parenthetical citation (eg: `Lane (2000)`), with the `citenp:[]` macro
+	NonParentheticalCitation string = "citenp"
+)
+
+// InlineCitation a citation of one or more entries of the bibliography (eg: `cite:[lane2000, hunt1999(p. 42)]`)
+type InlineCitation struct {
+	Kind       string // `cite` or `citenp`
+	References []*CitationReference
+}
+
+// CitationReference the reference to an entry of the bibliography in a citation
+type CitationReference struct {
+	Key     string
+	Locator string // optional location in the cited entry (eg: a page number)
+}
+
+// NewInlineCitation returns a new InlineCitation with the comma-separated keys of the given content
+func NewInlineCitation(kind, content string) (*InlineCitation, error) {
+	c := &InlineCitation{
+		Kind:       kind,
+		References: []*CitationReference{},
+	}
+	for _, key := range strings.Split(content, ",") {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		ref := &CitationReference{
+			Key: key,
+		}
+		// optional locator between parentheses, after the key (eg: `lane2000(p. 42)`)
+		if i := strings.Index(key, "("); i > 0 && strings.HasSuffix(key, ")") {
+			ref.Key = strings.TrimSpace(key[:i])
+			ref.Locator = strings.TrimSpace(key[i+1 : len(key)-1])
+		}
+		c.References = append(c.References, ref)
+	}
+	return c, nil
+}
+
+// BibliographyBlock the list of the entries of the BibTeX file cited in the document (ie, `bibliography::[]`)
+type BibliographyBlock struct {
+	Attributes Attributes
+}
+
+// NewBibliographyBlock returns a new BibliographyBlock
+func NewBibliographyBlock(attributes Attributes) (*BibliographyBlock, error) {
+	return &BibliographyBlock{
+		Attributes: attributes,
+	}, nil
+}
+
+var _ WithAttributes = &BibliographyBlock{}
+
+// GetAttributes returns the attributes of this BibliographyBlock
+func (b *BibliographyBlock) GetAttributes() Attributes {
+	return b.Attributes
+}
+
+// AddAttributes adds the attributes of this BibliographyBlock
+func (b *BibliographyBlock) AddAttributes(attributes Attributes) {
+	b.Attributes = b.Attributes.AddAll(attributes)
+}
+
+// SetAttributes sets the attributes in this BibliographyBlock
+func (b *BibliographyBlock) SetAttributes(attributes Attributes) {
+	b.Attributes = attributes
+}
+
 // ------------------------------------------
 // Inline Links
 // ------------------------------------------
@@ -3623,6 +4268,7 @@ func (l *Location) ToDisplayString() string {
 
 // IndexTerm a index term, with a single term
 type IndexTerm struct {
+	ID   string // the ID of the anchor of the term in the document, referenced in the index
 	Term []interface{}
 }
 
@@ -3633,8 +4279,31 @@ func NewIndexTerm(term []interface{}) (*IndexTerm, error) {
 	}, nil
 }
 
+// Text returns the text of the term, without its formatting
+func (t *IndexTerm) Text() string {
+	return strings.TrimSpace(plainText(t.Term))
+}
+
+func plainText(elements []interface{}) string {
+	result := &strings.Builder{}
+	for _, e := range elements {
+		switch e := e.(type) {
+		case *StringElement:
+			result.WriteString(e.Content)
+		case *SpecialCharacter:
+			result.WriteString(e.Name)
+		case *Symbol:
+			result.WriteString(e.Name)
+		case *QuotedText:
+			result.WriteString(plainText(e.Elements))
+		}
+	}
+	return result.String()
+}
+
 // ConcealedIndexTerm a concealed index term, with 1 required and 2 optional terms
 type ConcealedIndexTerm struct {
+	ID    string // the ID of the anchor of the term in the document, referenced in the index
 	Term1 interface{}
 	Term2 interface{}
 	Term3 interface{}
@@ -3649,6 +4318,83 @@ func NewConcealedIndexTerm(term1, term2, term3 interface{}) (*ConcealedIndexTerm
 	}, nil
 }
 
+// Terms returns the primary, secondary and tertiary terms (the latter two are optional)
+func (t *ConcealedIndexTerm) Terms() []string {
+	terms := []string{}
+	for _, term := range []interface{}{t.Term1, t.Term2, t.Term3} {
+		term, ok := term.(string)
+		if !ok || strings.TrimSpace(term) == "" {
+			break
+		}
+		terms = append(terms, strings.TrimSpace(term))
+	}
+	return terms
+}
+
+// Index the entries of the index of the document, sorted alphabetically
+type Index struct {
+	Entries []*IndexEntry
+}
+
+// IndexEntry an entry of the index, with the IDs of the anchors
+// of its occurrences in the document, and its own (sorted) entries
+type IndexEntry struct {
+	Term    string
+	Anchors []string
+	Entries []*IndexEntry
+}
+
+// Add adds an occurrence of the given terms (primary, secondary and tertiary) at the given anchor
+func (i *Index) Add(anchor string, terms ...string) {
+	entries := &i.Entries
+	for n, term := range terms {
+		var entry *IndexEntry
+		for _, e := range *entries {
+			if FoldIndexTerm(e.Term) == FoldIndexTerm(term) {
+				entry = e
+				break
+			}
+		}
+		if entry == nil {
+			entry = &IndexEntry{
+				Term: term,
+			}
+			// keep the entries sorted
+			p := sort.Search(len(*entries), func(j int) bool {
+				return compareIndexTerms((*entries)[j].Term, term) > 0
+			})
+			*entries = append(*entries, nil)
+			copy((*entries)[p+1:], (*entries)[p:])
+			(*entries)[p] = entry
+		}
+		if n == len(terms)-1 {
+			entry.Anchors = append(entry.Anchors, anchor)
+		}
+		entries = &entry.Entries
+	}
+}
+
+// compares the terms regardless of their case and accents (unless they only differ by their case or accents)
+func compareIndexTerms(t1, t2 string) int {
+	if c := strings.Compare(FoldIndexTerm(t1), FoldIndexTerm(t2)); c != 0 {
+		return c
+	}
+	return strings.Compare(t1, t2)
+}
+
+// FoldIndexTerm returns the given term in lower case and without its diacritics (eg: "Éclair" -> "eclair"),
+// so that the terms which only differ by their case or accents are grouped and sorted together in the index
+func FoldIndexTerm(term string) string {
+	result := &strings.Builder{}
+	for _, r := range norm.NFD.String(term) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		result.WriteRune(unicode.ToLower(r))
+	}
+	return result.String()
+}
+
 // ------------------------------------------------------------------------------------
 // Special Characters
 // They need to be identified as they may have a special treatment during the rendering
@@ -3712,10 +4458,21 @@ func (p *ElementPlaceHolder) String() string {
 
 // Table the structure for the tables
 type Table struct {
-	Attributes Attributes
-	Header     *TableRow
-	Footer     *TableRow
-	Rows       []*TableRow
+	Attributes     Attributes
+	Header         *TableRow
+	Footer         *TableRow
+	Rows           []*TableRow
+	SourcePosition *SourcePosition
+}
+
+// GetSourcePosition returns the position of this Table in the source
+func (t *Table) GetSourcePosition() *SourcePosition {
+	return t.SourcePosition
+}
+
+// SetSourcePosition sets the position of this Table in the source
+func (t *Table) SetSourcePosition(position *SourcePosition) {
+	t.SourcePosition = position
 }
 
 func NewTable(lines []interface{}) (*Table, error) {
@@ -3732,12 +4489,144 @@ func NewTable(lines []interface{}) (*Table, error) {
 	}, nil
 }
 
+const (
+	// PrefixSeparatedValues the default data format of tables, in which each cell is prefixed by the separator (eg: `| cell`)
+	PrefixSeparatedValues string = "psv"
+	// CommaSeparatedValues the CSV data format of tables (see RFC 4180)
+	CommaSeparatedValues string = "csv"
+	// TabSeparatedValues the TSV data format of tables (ie, CSV with tabs as separators)
+	TabSeparatedValues string = "tsv"
+	// DelimiterSeparatedValues the DSV data format of tables, in which values are separated by a colon (by default)
+	DelimiterSeparatedValues string = "dsv"
+)
+
+// DefaultTableFormat returns the data format of a table delimited with the given character
+// (ie, `csv` for `,===`, `dsv` for `:===` and `psv` otherwise)
+func DefaultTableFormat(delimiter string) string {
+	switch delimiter {
+	case ",":
+		return CommaSeparatedValues
+	case ":":
+		return DelimiterSeparatedValues
+	default:
+		return PrefixSeparatedValues
+	}
+}
+
+// DefaultTableSeparator returns the default cell separator for the given table data format
+// and delimiter character
+func DefaultTableSeparator(format, delimiter string) string {
+	switch format {
+	case CommaSeparatedValues:
+		return ","
+	case TabSeparatedValues:
+		return "\t"
+	case DelimiterSeparatedValues:
+		return ":"
+	default:
+		// nested tables (`!===`) use `!` as their cell separator
+		if delimiter == "!" {
+			return "!"
+		}
+		return "|"
+	}
+}
+
+// NewDelimiterSeparatedValuesTable returns a new table whose cells are the values
+// of the given lines, in the CSV, TSV or DSV format.
+// As for tables in the PSV format, the first line is the header if it is followed by a blank line.
+func NewDelimiterSeparatedValuesTable(lines []interface{}, format, separator string) (*Table, error) {
+	if log.IsLevelEnabled(log.DebugLevel) {
+		log.Debugf("new %s table with separator '%s' from %s", format, separator, spew.Sdump(lines))
+	}
+	header := false
+	if len(lines) > 2 {
+		_, ok1 := lines[0].(*RawLine)
+		_, ok2 := lines[1].(*BlankLine)
+		header = ok1 && ok2
+	}
+	content := &strings.Builder{}
+	for _, l := range lines {
+		if l, ok := l.(*RawLine); ok {
+			content.WriteString(l.Content)
+		}
+		content.WriteString("\n") // also retain blank lines, which may be part of a quoted value
+	}
+	var records [][]string
+	var err error
+	switch format {
+	case CommaSeparatedValues, TabSeparatedValues:
+		records, err = readCommaSeparatedValues(content.String(), separator)
+	default:
+		records = readDelimiterSeparatedValues(content.String(), separator)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read the %s content of table", format)
+	}
+	elements := make([]interface{}, 0, len(records)+1)
+	for i, record := range records {
+		r := &TableRow{
+			Cells: make([]*TableCell, len(record)),
+		}
+		for j, value := range record {
+			r.Cells[j] = &TableCell{
+				Elements: []interface{}{
+					&RawLine{
+						Content: strings.TrimSpace(value),
+					},
+				},
+			}
+		}
+		elements = append(elements, r)
+		if i == 0 && header {
+			elements = append(elements, &BlankLine{})
+		}
+	}
+	return NewTable(elements)
+}
+
+func readCommaSeparatedValues(content, separator string) ([][]string, error) {
+	r := csv.NewReader(strings.NewReader(content))
+	r.Comma, _ = utf8.DecodeRuneInString(separator)
+	r.FieldsPerRecord = -1 // records may have a variable number of fields
+	r.LazyQuotes = true
+	r.TrimLeadingSpace = true
+	return r.ReadAll()
+}
+
+func readDelimiterSeparatedValues(content, separator string) [][]string {
+	records := [][]string{}
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		// separators can be escaped with a backslash (eg: `\:`)
+		record := []string{}
+		value := &strings.Builder{}
+		for i := 0; i < len(line); i++ {
+			switch {
+			case line[i] == '\\' && strings.HasPrefix(line[i+1:], separator):
+				value.WriteString(separator)
+				i += len(separator)
+			case strings.HasPrefix(line[i:], separator):
+				record = append(record, value.String())
+				value.Reset()
+				i += len(separator) - 1
+			default:
+				value.WriteByte(line[i])
+			}
+		}
+		records = append(records, append(record, value.String()))
+	}
+	return records
+}
+
 func scanTableElements(rows []interface{}) (*TableRow, []*TableRow) {
 	// check first 2 elements, expecting a row followed by a blankline as the optional header row
 	if len(rows) > 2 {
 		if header, ok := rows[0].(*TableRow); ok {
 			if _, ok := rows[1].(*BlankLine); ok {
-				rows := organizeTableCells(rows[2:], len(header.Cells))
+				rows := organizeTableCells(rows[2:], header.width())
 				return header, rows
 			}
 		}
@@ -3745,7 +4634,7 @@ func scanTableElements(rows []interface{}) (*TableRow, []*TableRow) {
 	rowLength := 1
 	for _, e := range rows {
 		if r, ok := e.(*TableRow); ok {
-			rowLength = len(r.Cells)
+			rowLength = r.width()
 			break
 		}
 	}
@@ -3761,15 +4650,44 @@ func organizeTableCells(elements []interface{}, rowLength int) []*TableRow {
 			cells = append(cells, e.Cells...)
 		}
 	}
+	if rowLength < 1 {
+		rowLength = 1
+	}
 	log.Debugf("dispatching %d cells in rows of %d cells", len(cells), rowLength)
 	rows := make([]*TableRow, 0, int(len(cells)/rowLength)+1)
+	// number of rows (including the current one) in which each column is occupied by a cell with a `rowspan`
+	spans := make([]int, rowLength)
+	var r *TableRow
+	col := 0
 	for len(cells) > 0 {
-		r := &TableRow{}
-		rows = append(rows, r)
-		l := int(math.Min(float64(len(cells)), float64(rowLength)))
-		r.Cells = make([]*TableCell, l)
-		copy(r.Cells, cells[:l])
-		cells = cells[l:]
+		if r == nil {
+			r = &TableRow{
+				Cells: []*TableCell{},
+			}
+			rows = append(rows, r)
+			col = 0
+		}
+		// skip the columns occupied by cells of the previous rows (or by the previous cell of this row)
+		for col < rowLength && spans[col] > 0 {
+			col++
+		}
+		if col >= rowLength {
+			// row is complete
+			for i := range spans {
+				if spans[i] > 0 {
+					spans[i]--
+				}
+			}
+			r = nil
+			continue
+		}
+		c := cells[0]
+		cells = cells[1:]
+		r.Cells = append(r.Cells, c)
+		for i := col; i < col+c.ColSpan() && i < rowLength; i++ {
+			spans[i] = c.RowSpan()
+		}
+		col += c.ColSpan()
 	}
 	return rows
 }
@@ -4008,8 +4926,8 @@ func (t *Table) Columns() ([]*TableColumn, error) {
 	}
 	// add empty entries if the first row has more cells than the number of column than what's specified in the `cols` attribute
 	// (also works when the `cols` attribute is missing/empty)
-	if len(t.Rows) > 0 && len(t.Rows[0].Cells) > len(result) {
-		m := len(t.Rows[0].Cells) - len(result)
+	if len(t.Rows) > 0 && t.Rows[0].width() > len(result) {
+		m := t.Rows[0].width() - len(result)
 		for i := 0; i < m; i++ {
 			col := newDefaultTableColumn()
 			result = append(result, col)
@@ -4061,11 +4979,23 @@ type TableRow struct {
 }
 
 func NewTableRow(elements []interface{}) (*TableRow, error) {
-	cells := make([]*TableCell, len(elements))
-	for i, e := range elements {
+	cells := make([]*TableCell, 0, len(elements))
+	for _, e := range elements {
 		switch e := e.(type) {
 		case *TableCell:
-			cells[i] = e
+			// expand the cells with a duplication factor (eg: `3*|`)
+			if n := e.Attributes.GetAsIntWithDefault(AttrDuplicate, 1); n > 1 {
+				delete(e.Attributes, AttrDuplicate)
+				if len(e.Attributes) == 0 {
+					e.Attributes = nil
+				}
+				cells = append(cells, e)
+				for i := 1; i < n; i++ {
+					cells = append(cells, e.clone())
+				}
+				continue
+			}
+			cells = append(cells, e)
 		default:
 			return nil, fmt.Errorf("unexpected type of table cell: '%T'", e)
 		}
@@ -4075,6 +5005,15 @@ func NewTableRow(elements []interface{}) (*TableRow, error) {
 	}, nil
 }
 
+// width returns the number of columns spanned by the cells of this row
+func (r *TableRow) width() int {
+	w := 0
+	for _, c := range r.Cells {
+		w += c.ColSpan()
+	}
+	return w
+}
+
 var _ WithElements = &TableRow{}
 
 func (r *TableRow) GetAttributes() Attributes {
@@ -4111,19 +5050,33 @@ func (r *TableRow) SetElements(elements []interface{}) error {
 }
 
 type TableCell struct {
-	Format   string
-	Elements []interface{}
+	Format         string // the style of the cell content (eg: `a` for AsciiDoc content)
+	Attributes     Attributes
+	Elements       []interface{}
+	SourcePosition *SourcePosition
+}
+
+// GetSourcePosition returns the position of this TableCell in the source
+func (c *TableCell) GetSourcePosition() *SourcePosition {
+	return c.SourcePosition
+}
+
+// SetSourcePosition sets the position of this TableCell in the source
+func (c *TableCell) SetSourcePosition(position *SourcePosition) {
+	c.SourcePosition = position
 }
 
-func NewInlineTableCell(content *RawLine) (*TableCell, error) {
-	return &TableCell{
+func NewInlineTableCell(content *RawLine, specifier interface{}) (*TableCell, error) {
+	c := &TableCell{
 		Elements: []interface{}{
 			content,
 		},
-	}, nil
+	}
+	c.setSpecifier(specifier)
+	return c, nil
 }
 
-func NewMultilineTableCell(elements []interface{}, format interface{}) (*TableCell, error) {
+func NewMultilineTableCell(elements []interface{}, specifier interface{}) (*TableCell, error) {
 	for i, l := range elements {
 		// append `\n` unless the we're on the last element
 		if l, ok := l.(*RawLine); ok && i < len(elements)-1 {
@@ -4133,22 +5086,110 @@ func NewMultilineTableCell(elements []interface{}, format interface{}) (*TableCe
 	c := &TableCell{
 		Elements: elements,
 	}
-	if format, ok := format.(string); ok {
-		c.Format = format
-	}
+	c.setSpecifier(specifier)
 	return c, nil
 }
 
+// NewTableCellSpecifier returns the attributes of a cell specifier, which is made of an optional
+// span (eg: `2+`, `.3+` or `2.3+`) or duplication factor (eg: `3*`), followed by an optional
+// horizontal alignment, vertical alignment and style (eg: `^.>a`)
+func NewTableCellSpecifier(colspan, rowspan, operator, halign, valign, style interface{}) (Attributes, error) {
+	attrs := Attributes{}
+	switch operator {
+	case "+":
+		if colspan, ok := colspan.(int); ok && colspan > 1 {
+			attrs[AttrColSpan] = colspan
+		}
+		if rowspan, ok := rowspan.(int); ok && rowspan > 1 {
+			attrs[AttrRowSpan] = rowspan
+		}
+	case "*":
+		if duplicate, ok := colspan.(int); ok && duplicate > 1 {
+			attrs[AttrDuplicate] = duplicate
+		}
+	}
+	if halign, ok := halign.(HAlign); ok {
+		attrs[AttrHAlign] = halign
+	}
+	if valign, ok := valign.(VAlign); ok {
+		attrs[AttrVAlign] = valign
+	}
+	if style, ok := style.(string); ok {
+		attrs[AttrStyle] = style
+	}
+	return attrs, nil
+}
+
+func (c *TableCell) setSpecifier(specifier interface{}) {
+	attrs, ok := specifier.(Attributes)
+	if !ok {
+		return
+	}
+	attrs = attrs.Clone()
+	if style, ok := attrs[AttrStyle].(string); ok {
+		c.Format = style
+		delete(attrs, AttrStyle)
+	}
+	if len(attrs) > 0 {
+		c.Attributes = attrs
+	}
+}
+
+// clone returns a copy of this cell, with copies of its attributes and raw lines
+func (c *TableCell) clone() *TableCell {
+	elements := make([]interface{}, len(c.Elements))
+	for i, e := range c.Elements {
+		if l, ok := e.(*RawLine); ok {
+			l := *l
+			e = &l
+		}
+		elements[i] = e
+	}
+	result := &TableCell{
+		Format:         c.Format,
+		Elements:       elements,
+		SourcePosition: c.SourcePosition,
+	}
+	if c.Attributes != nil {
+		result.Attributes = c.Attributes.Clone()
+	}
+	return result
+}
+
+// ColSpan returns the number of columns spanned by this cell
+func (c *TableCell) ColSpan() int {
+	return c.Attributes.GetAsIntWithDefault(AttrColSpan, 1)
+}
+
+// RowSpan returns the number of rows spanned by this cell
+func (c *TableCell) RowSpan() int {
+	return c.Attributes.GetAsIntWithDefault(AttrRowSpan, 1)
+}
+
+// HAlign returns the horizontal alignment of this cell, if it was specified
+func (c *TableCell) HAlign() (HAlign, bool) {
+	a, ok := c.Attributes[AttrHAlign].(HAlign)
+	return a, ok
+}
+
+// VAlign returns the vertical alignment of this cell, if it was specified
+func (c *TableCell) VAlign() (VAlign, bool) {
+	a, ok := c.Attributes[AttrVAlign].(VAlign)
+	return a, ok
+}
+
 var _ WithElements = &TableCell{}
 
 func (c *TableCell) GetAttributes() Attributes {
-	return nil
+	return c.Attributes
 }
 
-func (c *TableCell) AddAttributes(_ Attributes) {
+func (c *TableCell) AddAttributes(attributes Attributes) {
+	c.Attributes = c.Attributes.AddAll(attributes)
 }
 
-func (c *TableCell) SetAttributes(_ Attributes) {
+func (c *TableCell) SetAttributes(attributes Attributes) {
+	c.Attributes = attributes
 }
 
 func (c *TableCell) GetElements() []interface{} {
@@ -0,0 +1,338 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// ------------------------------------------
+// Walk
+// ------------------------------------------
+
+// Visitor the visitor of the elements of a document, called by `Walk` when entering and when leaving each element.
+// The visitor can replace or delete the current element, or skip its children, using the given cursor.
+type Visitor interface {
+	// Enter is called before the children of the current element are visited
+	Enter(c *Cursor) error
+	// Leave is called after the children of the current element were visited (or skipped)
+	Leave(c *Cursor) error
+}
+
+// VisitorFuncs a Visitor made of optional `Enter` and `Leave` funcs
+type VisitorFuncs struct {
+	EnterFunc func(c *Cursor) error
+	LeaveFunc func(c *Cursor) error
+}
+
+var _ Visitor = VisitorFuncs{}
+
+// Enter calls the `EnterFunc`, if defined
+func (v VisitorFuncs) Enter(c *Cursor) error {
+	if v.EnterFunc == nil {
+		return nil
+	}
+	return v.EnterFunc(c)
+}
+
+// Leave calls the `LeaveFunc`, if defined
+func (v VisitorFuncs) Leave(c *Cursor) error {
+	if v.LeaveFunc == nil {
+		return nil
+	}
+	return v.LeaveFunc(c)
+}
+
+// Cursor the position of the element being visited
+type Cursor struct {
+	element      interface{}
+	parent       interface{}
+	replaced     bool
+	deleted      bool
+	skipChildren bool
+}
+
+// Element returns the current element (ie, the replacement if the element was replaced)
+func (c *Cursor) Element() interface{} {
+	return c.element
+}
+
+// Parent returns the parent of the current element, or `nil` if the current element is the root of the walk
+func (c *Cursor) Parent() interface{} {
+	return c.parent
+}
+
+// Replace replaces the current element with the given one.
+// When called in `Enter`, then the children of the replacement are visited (unless they are skipped).
+// Note: in the elements whose children have a specific type (eg: the elements of a list or the rows of a table),
+// the replacement must have the same type.
+func (c *Cursor) Replace(element interface{}) {
+	c.element = element
+	c.replaced = true
+	c.deleted = false
+}
+
+// Delete deletes the current element.
+// When called in `Enter`, then the children of the element are not visited, and `Leave` is not called.
+func (c *Cursor) Delete() {
+	c.element = nil
+	c.replaced = false
+	c.deleted = true
+}
+
+// SkipChildren skips the children of the current element (only meaningful when called in `Enter`)
+func (c *Cursor) SkipChildren() {
+	c.skipChildren = true
+}
+
+// Walk traverses the given element (typically, a `*Document`) in depth-first order, along with all its children:
+// the blocks and inline elements of the document, including the title of the sections, the elements of the lists,
+// the list continuations, the rows and cells of the tables, the footnotes, the terms of the labeled lists and index terms,
+// and the inline elements in the attributes (eg: the title of a block or the text of a link).
+// The root element itself can neither be replaced nor deleted.
+func Walk(element interface{}, v Visitor) error {
+	_, err := walk(v, nil, element)
+	return err
+}
+
+// walk visits the given element and its children, and returns the cursor which tells
+// if the element was replaced (and with which element) or deleted
+func walk(v Visitor, parent, element interface{}) (*Cursor, error) {
+	c := &Cursor{
+		element: element,
+		parent:  parent,
+	}
+	if err := v.Enter(c); err != nil {
+		return nil, err
+	}
+	if c.deleted {
+		return c, nil
+	}
+	if !c.skipChildren {
+		if err := walkChildren(v, c.element); err != nil {
+			return nil, err
+		}
+	}
+	if err := v.Leave(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func walkChildren(v Visitor, element interface{}) error {
+	var err error
+	switch e := element.(type) {
+	case *Document:
+		if e.Elements, err = walkElements(v, e, e.Elements); err != nil {
+			return err
+		}
+		footnotes := make([]*Footnote, 0, len(e.Footnotes))
+		for _, f := range e.Footnotes {
+			c, err := walk(v, e, f)
+			if err != nil {
+				return err
+			}
+			if c.deleted {
+				continue
+			}
+			f, ok := c.element.(*Footnote)
+			if !ok {
+				return errors.Errorf("unexpected type of footnote replacement: '%T'", c.element)
+			}
+			footnotes = append(footnotes, f)
+		}
+		if e.Footnotes != nil {
+			e.Footnotes = footnotes
+		}
+		return nil
+	case *DocumentHeader:
+		if e.Title, err = walkElements(v, e, e.Title); err != nil {
+			return err
+		}
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *Section:
+		if e.Title, err = walkElements(v, e, e.Title); err != nil {
+			return err
+		}
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *LabeledListElement:
+		if e.Term, err = walkElements(v, e, e.Term); err != nil {
+			return err
+		}
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *Preamble:
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *Paragraph:
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *DelimitedBlock:
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *OrderedListElement:
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *UnorderedListElement:
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *CalloutListElement:
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *ListElements:
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *QuotedText:
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *InlinePassthrough:
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *Footnote:
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *TableCell:
+		e.Elements, err = walkElements(v, e, e.Elements)
+	case *IndexTerm:
+		e.Term, err = walkElements(v, e, e.Term)
+	case *ConcealedIndexTerm:
+		if e.Term1, err = walkValue(v, e, e.Term1); err != nil {
+			return err
+		}
+		if e.Term2, err = walkValue(v, e, e.Term2); err != nil {
+			return err
+		}
+		e.Term3, err = walkValue(v, e, e.Term3)
+	case *InternalCrossReference:
+		e.Label, err = walkValue(v, e, e.Label)
+	case *ListContinuation:
+		e.Element, err = walkValue(v, e, e.Element)
+	case *List:
+		elements := make([]ListElement, 0, len(e.Elements))
+		for _, elmt := range e.Elements {
+			c, err := walk(v, e, elmt)
+			if err != nil {
+				return err
+			}
+			if c.deleted {
+				continue
+			}
+			elmt, ok := c.element.(ListElement)
+			if !ok {
+				return errors.Errorf("unexpected type of list element replacement: '%T'", c.element)
+			}
+			elements = append(elements, elmt)
+		}
+		if e.Elements != nil {
+			e.Elements = elements
+		}
+	case *Table:
+		if e.Header, err = walkTableRow(v, e, e.Header); err != nil {
+			return err
+		}
+		rows := make([]*TableRow, 0, len(e.Rows))
+		for _, row := range e.Rows {
+			r, err := walkTableRow(v, e, row)
+			if err != nil {
+				return err
+			}
+			if r != nil {
+				rows = append(rows, r)
+			}
+		}
+		if e.Rows != nil {
+			e.Rows = rows
+		}
+		e.Footer, err = walkTableRow(v, e, e.Footer)
+	case *TableRow:
+		cells := make([]*TableCell, 0, len(e.Cells))
+		for _, cell := range e.Cells {
+			c, err := walk(v, e, cell)
+			if err != nil {
+				return err
+			}
+			if c.deleted {
+				continue
+			}
+			cell, ok := c.element.(*TableCell)
+			if !ok {
+				return errors.Errorf("unexpected type of table cell replacement: '%T'", c.element)
+			}
+			cells = append(cells, cell)
+		}
+		if e.Cells != nil {
+			e.Cells = cells
+		}
+	}
+	if err != nil {
+		return err
+	}
+	// also, visit the inline elements in the attributes (eg: the title of a block)
+	if e, ok := element.(WithAttributes); ok {
+		return walkAttributes(v, element, e.GetAttributes())
+	}
+	return nil
+}
+
+// walkElements visits the given elements, and returns the remaining elements and replacements
+// (or the given elements themselves if none was replaced nor deleted)
+func walkElements(v Visitor, parent interface{}, elements []interface{}) ([]interface{}, error) {
+	var result []interface{} // only allocated when an element is replaced or deleted
+	for i, e := range elements {
+		c, err := walk(v, parent, e)
+		if err != nil {
+			return nil, err
+		}
+		if result == nil && (c.deleted || c.replaced) {
+			result = make([]interface{}, i, len(elements))
+			copy(result, elements[:i])
+		}
+		if result != nil && !c.deleted {
+			result = append(result, c.element)
+		}
+	}
+	if result == nil {
+		return elements, nil
+	}
+	return result, nil
+}
+
+// walkValue visits the given value, which can be a single element or a slice of elements.
+// Plain strings and `nil` are not elements, hence they are not visited.
+func walkValue(v Visitor, parent interface{}, value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case nil, string:
+		return value, nil
+	case []interface{}:
+		return walkElements(v, parent, value)
+	default:
+		c, err := walk(v, parent, value)
+		if err != nil {
+			return nil, err
+		}
+		return c.element, nil
+	}
+}
+
+func walkTableRow(v Visitor, parent interface{}, row *TableRow) (*TableRow, error) {
+	if row == nil {
+		return nil, nil
+	}
+	c, err := walk(v, parent, row)
+	if err != nil || c.deleted {
+		return nil, err
+	}
+	result, ok := c.element.(*TableRow)
+	if !ok {
+		return nil, errors.Errorf("unexpected type of table row replacement: '%T'", c.element)
+	}
+	return result, nil
+}
+
+// walkAttributes visits the inline elements of the attribute values, in the order of the attribute keys
+func walkAttributes(v Visitor, parent interface{}, attrs Attributes) error {
+	keys := make([]string, 0, len(attrs))
+	for k, value := range attrs {
+		if _, ok := value.([]interface{}); ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		elements, err := walkElements(v, parent, attrs[k].([]interface{}))
+		if err != nil {
+			return err
+		}
+		attrs[k] = elements
+	}
+	return nil
+}
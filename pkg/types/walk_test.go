@@ -0,0 +1,307 @@
+package types_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("walk", func() {
+
+	var doc *types.Document
+
+	BeforeEach(func() {
+		doc = &types.Document{
+			Elements: []interface{}{
+				&types.Section{
+					Level: 1,
+					Title: []interface{}{
+						&types.StringElement{Content: "Section"},
+					},
+					Elements: []interface{}{
+						&types.Paragraph{
+							Attributes: types.Attributes{
+								types.AttrTitle: []interface{}{
+									&types.StringElement{Content: "Title"},
+								},
+							},
+							Elements: []interface{}{
+								&types.StringElement{Content: "some "},
+								&types.QuotedText{
+									Kind: types.SingleQuoteBold,
+									Elements: []interface{}{
+										&types.StringElement{Content: "bold"},
+									},
+								},
+								&types.FootnoteReference{ID: 1},
+							},
+						},
+						&types.List{
+							Kind: types.LabeledListKind,
+							Elements: []types.ListElement{
+								&types.LabeledListElement{
+									Term: []interface{}{
+										&types.StringElement{Content: "term"},
+									},
+									Elements: []interface{}{
+										&types.Paragraph{
+											Elements: []interface{}{
+												&types.StringElement{Content: "description"},
+											},
+										},
+									},
+								},
+							},
+						},
+						&types.Table{
+							Header: &types.TableRow{
+								Cells: []*types.TableCell{
+									{
+										Elements: []interface{}{
+											&types.StringElement{Content: "header"},
+										},
+									},
+								},
+							},
+							Rows: []*types.TableRow{
+								{
+									Cells: []*types.TableCell{
+										{
+											Elements: []interface{}{
+												&types.StringElement{Content: "cell"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Footnotes: []*types.Footnote{
+				{
+					ID: 1,
+					Elements: []interface{}{
+						&types.StringElement{Content: "a note"},
+					},
+				},
+			},
+		}
+	})
+
+	It("should visit all elements", func() {
+		visited := []string{}
+		err := types.Walk(doc, types.VisitorFuncs{
+			EnterFunc: func(c *types.Cursor) error {
+				visited = append(visited, "enter "+name(c.Element()))
+				return nil
+			},
+			LeaveFunc: func(c *types.Cursor) error {
+				visited = append(visited, "leave "+name(c.Element()))
+				return nil
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(visited).To(Equal([]string{
+			"enter Document",
+			"enter Section",
+			"enter StringElement(Section)",
+			"leave StringElement(Section)",
+			"enter Paragraph",
+			"enter StringElement(some )",
+			"leave StringElement(some )",
+			"enter QuotedText",
+			"enter StringElement(bold)",
+			"leave StringElement(bold)",
+			"leave QuotedText",
+			"enter FootnoteReference",
+			"leave FootnoteReference",
+			"enter StringElement(Title)",
+			"leave StringElement(Title)",
+			"leave Paragraph",
+			"enter List",
+			"enter LabeledListElement",
+			"enter StringElement(term)",
+			"leave StringElement(term)",
+			"enter Paragraph",
+			"enter StringElement(description)",
+			"leave StringElement(description)",
+			"leave Paragraph",
+			"leave LabeledListElement",
+			"leave List",
+			"enter Table",
+			"enter TableRow",
+			"enter TableCell",
+			"enter StringElement(header)",
+			"leave StringElement(header)",
+			"leave TableCell",
+			"leave TableRow",
+			"enter TableRow",
+			"enter TableCell",
+			"enter StringElement(cell)",
+			"leave StringElement(cell)",
+			"leave TableCell",
+			"leave TableRow",
+			"leave Table",
+			"leave Section",
+			"enter Footnote",
+			"enter StringElement(a note)",
+			"leave StringElement(a note)",
+			"leave Footnote",
+			"leave Document",
+		}))
+	})
+
+	It("should provide parent", func() {
+		var parent interface{}
+		err := types.Walk(doc, types.VisitorFuncs{
+			EnterFunc: func(c *types.Cursor) error {
+				if s, ok := c.Element().(*types.StringElement); ok && s.Content == "bold" {
+					parent = c.Parent()
+				}
+				return nil
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parent).To(BeAssignableToTypeOf(&types.QuotedText{}))
+	})
+
+	It("should replace and delete elements", func() {
+		err := types.Walk(doc, types.VisitorFuncs{
+			EnterFunc: func(c *types.Cursor) error {
+				switch e := c.Element().(type) {
+				case *types.QuotedText:
+					// replace the quoted text with its content, which is also visited
+					c.Replace(&types.InlinePassthrough{
+						Elements: e.Elements,
+					})
+				case *types.StringElement:
+					c.Replace(&types.StringElement{Content: strings.ToUpper(e.Content)})
+				case *types.List:
+					c.Delete()
+				}
+				return nil
+			},
+			LeaveFunc: func(c *types.Cursor) error {
+				if _, ok := c.Element().(*types.TableRow); ok {
+					c.Delete()
+				}
+				return nil
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		section := doc.Elements[0].(*types.Section)
+		Expect(section.Title).To(Equal([]interface{}{
+			&types.StringElement{Content: "SECTION"},
+		}))
+		Expect(section.Elements).To(Equal([]interface{}{
+			&types.Paragraph{
+				Attributes: types.Attributes{
+					types.AttrTitle: []interface{}{
+						&types.StringElement{Content: "TITLE"},
+					},
+				},
+				Elements: []interface{}{
+					&types.StringElement{Content: "SOME "},
+					&types.InlinePassthrough{
+						Elements: []interface{}{
+							&types.StringElement{Content: "BOLD"},
+						},
+					},
+					&types.FootnoteReference{ID: 1},
+				},
+			},
+			&types.Table{
+				Rows: []*types.TableRow{},
+			},
+		}))
+		Expect(doc.Footnotes[0].Elements).To(Equal([]interface{}{
+			&types.StringElement{Content: "A NOTE"},
+		}))
+	})
+
+	It("should skip children", func() {
+		visited := 0
+		err := types.Walk(doc, types.VisitorFuncs{
+			EnterFunc: func(c *types.Cursor) error {
+				visited++
+				if _, ok := c.Element().(*types.Section); ok {
+					c.SkipChildren()
+				}
+				return nil
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		// document, section, footnote and its content
+		Expect(visited).To(Equal(4))
+	})
+
+	It("should visit list continuation", func() {
+		elements := &types.ListElements{
+			Elements: []interface{}{
+				&types.ListContinuation{
+					Offset: 0,
+					Element: &types.Paragraph{
+						Elements: []interface{}{
+							&types.StringElement{Content: "continuation"},
+						},
+					},
+				},
+			},
+		}
+		visited := []string{}
+		err := types.Walk(elements, types.VisitorFuncs{
+			EnterFunc: func(c *types.Cursor) error {
+				visited = append(visited, name(c.Element()))
+				return nil
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(visited).To(Equal([]string{
+			"ListElements",
+			"ListContinuation",
+			"Paragraph",
+			"StringElement(continuation)",
+		}))
+	})
+
+	It("should fail with invalid replacement", func() {
+		err := types.Walk(doc, types.VisitorFuncs{
+			EnterFunc: func(c *types.Cursor) error {
+				if _, ok := c.Element().(*types.LabeledListElement); ok {
+					c.Replace(&types.Paragraph{})
+				}
+				return nil
+			},
+		})
+		Expect(err).To(MatchError("unexpected type of list element replacement: '*types.Paragraph'"))
+	})
+
+	It("should stop on error", func() {
+		visited := 0
+		err := types.Walk(doc, types.VisitorFuncs{
+			EnterFunc: func(c *types.Cursor) error {
+				visited++
+				if _, ok := c.Element().(*types.Paragraph); ok {
+					return errors.New("paragraphs are not allowed")
+				}
+				return nil
+			},
+		})
+		Expect(err).To(MatchError("paragraphs are not allowed"))
+		// document, section, section title and paragraph
+		Expect(visited).To(Equal(4))
+	})
+})
+
+func name(element interface{}) string {
+	if s, ok := element.(*types.StringElement); ok {
+		return fmt.Sprintf("StringElement(%s)", s.Content)
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", element), "*types.")
+}
@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -9,28 +10,38 @@ import (
 // Validate validates the given document
 // May also alter some attributes (eg: doctype from `manpage` to `article`)
 func Validate(doc *types.Document, doctype string) ([]Problem, error) {
-	if doctype == "manpage" {
+	switch doctype {
+	case "manpage":
 		return validateManpage(doc), nil
+	case "book":
+		return validateBook(doc), nil
 	}
 	return nil, nil
 }
 
 // Problem a problem detected during validation
-// Must have a severity and an associated message
-// TODO: include element position once available in the AST.
+// Must have a severity and an associated message, and may have the position
+// of the element in the source
 type Problem struct {
-	Severity Severity
+	Severity types.DiagnosticSeverity
 	Message  string
+	Position *types.SourcePosition
 }
 
 // Severity the problem severity
-type Severity string
+//
+// Deprecated: use types.DiagnosticSeverity instead.
+type Severity = types.DiagnosticSeverity
 
 const (
 	// Error the severity level for errors.
-	Error Severity = "Error"
+	//
+	// Deprecated: use types.SeverityError instead.
+	Error = types.SeverityError
 	// Warning the severity level for warning
-	Warning Severity = "Warning"
+	//
+	// Deprecated: use types.SeverityWarning instead.
+	Warning = types.SeverityWarning
 )
 
 // validateManpage checks that the document has the expected structure, ie:
@@ -44,30 +55,57 @@ func validateManpage(doc *types.Document) []Problem {
 	// checks the presence of a header
 	if header, _ := doc.Header(); header == nil {
 		problems = append(problems, Problem{
-			Severity: Error,
+			Severity: types.SeverityError,
 			Message:  "manpage document is missing a header",
 		})
 	}
 	elements := doc.BodyElements()
-	if nameSection, ok := assertThatElement(elements[0]).isSection(withLevel(1), withTitle("name")); !ok {
+	if nameSection, ok := assertThatElement(elementAt(elements, 0)).isSection(withLevel(1), withTitle("name")); !ok {
 		problems = append(problems, Problem{
-			Severity: Error,
+			Severity: types.SeverityError,
 			Message:  "manpage document is missing the 'Name' section",
 		})
 	} else if ok := assertThatElements(nameSection.Elements).haveCount(1); !ok {
 		problems = append(problems, Problem{
-			Severity: Error,
+			Severity: types.SeverityError,
 			Message:  "'Name' section should contain a single paragraph",
+			Position: nameSection.GetSourcePosition(),
 		})
-	} else if _, ok := assertThatElement(elements[1]).isSection(withLevel(1), withTitle("synopsis")); !ok {
+	} else if _, ok := assertThatElement(elementAt(elements, 1)).isSection(withLevel(1), withTitle("synopsis")); !ok {
 		problems = append(problems, Problem{
-			Severity: Error,
+			Severity: types.SeverityError,
 			Message:  "manpage document is missing the 'Synopsis' section",
 		})
 	}
 	return problems
 }
 
+// validateBook checks that each part of the book (ie, each section of level 0)
+// contains at least one chapter (ie, a section of level 1)
+func validateBook(doc *types.Document) []Problem {
+	problems := []Problem{}
+	for _, e := range doc.BodyElements() {
+		if part, ok := assertThatElement(e).isSection(withLevel(0)); ok && part.GetStyle() == "" {
+			if !assertThatElements(part.Elements).haveSection(withLevel(1)) {
+				problems = append(problems, Problem{
+					Severity: types.SeverityWarning,
+					Message:  fmt.Sprintf("invalid part '%s': it must contain at least one section (eg: a chapter)", part.GetID()),
+					Position: part.GetSourcePosition(),
+				})
+			}
+		}
+	}
+	return problems
+}
+
+// elementAt returns the element at the given index, or `nil` if there is no such element
+func elementAt(elements []interface{}, i int) interface{} {
+	if i < len(elements) {
+		return elements[i]
+	}
+	return nil
+}
+
 // assert performs a set of assertions on a given element
 func assertThatElement(element interface{}) elementAssertion {
 	return elementAssertion{
@@ -123,3 +161,12 @@ type elementsAssertion struct {
 func (e elementsAssertion) haveCount(count int) bool {
 	return len(e.elements) == count
 }
+
+func (e elementsAssertion) haveSection(assertions ...sectionAssertion) bool {
+	for _, element := range e.elements {
+		if _, ok := assertThatElement(element).isSection(assertions...); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -39,6 +39,65 @@ var _ = Describe("document validator", func() {
 		})
 	})
 
+	Context("book", func() {
+
+		It("should not report problems", func() {
+			// given
+			doc := &types.Document{
+				Elements: []interface{}{
+					&types.Section{
+						Attributes: types.Attributes{
+							types.AttrID: "_part_one",
+						},
+						Level: 0,
+						Elements: []interface{}{
+							&types.Section{
+								Attributes: types.Attributes{
+									types.AttrID: "_chapter_one",
+								},
+								Level: 1,
+							},
+						},
+					},
+				},
+			}
+
+			// when
+			problems, err := Validate(doc, "book")
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(problems).To(BeEmpty()) // no problem found
+		})
+
+		It("should report part without chapter", func() {
+			// given
+			doc := &types.Document{
+				Elements: []interface{}{
+					&types.Section{
+						Attributes: types.Attributes{
+							types.AttrID: "_part_one",
+						},
+						Level: 0,
+						Elements: []interface{}{
+							&types.Paragraph{},
+						},
+					},
+				},
+			}
+
+			// when
+			problems, err := Validate(doc, "book")
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(problems).To(ConsistOf(Problem{
+				Severity: Warning,
+				Message:  "invalid part '_part_one': it must contain at least one section (eg: a chapter)",
+			}))
+		})
+	})
+
 	Context("manpage", func() {
 
 		It("should not report problems", func() {
@@ -435,6 +494,73 @@ var _ = Describe("document validator", func() {
 					Message:  "manpage document is missing the 'Synopsis' section",
 				}))
 			})
+
+			It("missing synopsis section - no other section", func() {
+				// given
+				doc := &types.Document{
+					Elements: []interface{}{
+						&types.DocumentHeader{
+							Title: []interface{}{
+								&types.StringElement{
+									Content: "foo",
+								},
+							},
+						},
+						&types.Section{
+							Level: 1,
+							Title: []interface{}{
+								&types.StringElement{
+									Content: "Name",
+								},
+							},
+							Elements: []interface{}{
+								&types.Paragraph{
+									Elements: []interface{}{
+										&types.StringElement{
+											Content: "a single paragraph to describe the program",
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				// when
+				problems, err := Validate(doc, "manpage")
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(problems).To(ConsistOf(Problem{
+					Severity: Error,
+					Message:  "manpage document is missing the 'Synopsis' section",
+				}))
+			})
+
+			It("missing name section - empty body", func() {
+				// given
+				doc := &types.Document{
+					Elements: []interface{}{
+						&types.DocumentHeader{
+							Title: []interface{}{
+								&types.StringElement{
+									Content: "foo",
+								},
+							},
+						},
+					},
+				}
+
+				// when
+				problems, err := Validate(doc, "manpage")
+
+				// then
+				Expect(err).NotTo(HaveOccurred())
+				Expect(problems).To(ConsistOf(Problem{
+					Severity: Error,
+					Message:  "manpage document is missing the 'Name' section",
+				}))
+			})
 		})
 	})
 
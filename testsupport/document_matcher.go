@@ -26,17 +26,21 @@ type documentMatcher struct {
 	diffs    string
 }
 
-var opts = []cmp.Option{cmpopts.IgnoreUnexported(
-	types.List{},
-	types.DelimitedBlock{},
-	types.Footnotes{},
-	types.TableOfContents{},
-	types.AttributeDeclaration{},
-	types.AttributeReference{},
-	types.AttributeReset{},
-	types.CounterSubstitution{},
-	types.PredefinedAttribute{},
-)}
+var opts = []cmp.Option{
+	// positions of the elements in the source are verified separately
+	cmpopts.IgnoreTypes(&types.SourcePosition{}),
+	cmpopts.IgnoreUnexported(
+		types.List{},
+		types.DelimitedBlock{},
+		types.Footnotes{},
+		types.TableOfContents{},
+		types.AttributeDeclaration{},
+		types.AttributeReference{},
+		types.AttributeReset{},
+		types.CounterSubstitution{},
+		types.PredefinedAttribute{},
+	),
+}
 
 func (m *documentMatcher) Match(actual interface{}) (success bool, err error) {
 	if _, ok := actual.(*types.Document); !ok {
@@ -28,12 +28,12 @@ func ParseDocument(actual string, options ...interface{}) (*types.Document, erro
 		}
 	}
 	c := configuration.NewConfiguration(allSettings...)
-	p, err := parser.Preprocess(strings.NewReader(actual), c, opts...)
+	p, m, err := parser.PreprocessWithSourceMap(strings.NewReader(actual), c, opts...)
 	if err != nil {
 		return nil, err
 	}
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debugf("preparsed document:\n%s", p)
 	}
-	return parser.ParseDocument(strings.NewReader(p), c, opts...)
+	return parser.ParseDocument(strings.NewReader(p), c, append(opts, parser.WithSourceMap(m))...)
 }
@@ -23,6 +23,10 @@ var _ = Describe("parse document fragment groups", func() {
 							Content: "hello, world!",
 						},
 					},
+					SourcePosition: &types.SourcePosition{
+						Line:   1,
+						Column: 1,
+					},
 				},
 			},
 		},
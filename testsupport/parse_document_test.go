@@ -1,6 +1,8 @@
 package testsupport_test
 
 import (
+	"path/filepath"
+
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -10,6 +12,7 @@ import (
 
 var _ = Describe("parse document", func() {
 
+	filename, _ := filepath.Abs("test.adoc")
 	expected := &types.Document{
 		Elements: []interface{}{
 			&types.Paragraph{
@@ -18,6 +21,11 @@ var _ = Describe("parse document", func() {
 						Content: "hello, world!",
 					},
 				},
+				SourcePosition: &types.SourcePosition{
+					File:   filename,
+					Line:   1,
+					Column: 1,
+				},
 			},
 		},
 	}